@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package main
+
+import (
+	"fmt"
+	"github.com/ezcon-foundation/go-ezcon/config"
+	"github.com/ezcon-foundation/go-ezcon/core/ledger"
+	"github.com/ezcon-foundation/go-ezcon/core/storage"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	SpecFlag = &cli.StringFlag{
+		Name:     "spec",
+		Usage:    "Genesis spec file (TOML)",
+		Required: true,
+	}
+
+	genesisCommand = &cli.Command{
+		Name:   "genesis",
+		Usage:  "Create ledger 0 from a genesis spec file",
+		Flags:  []cli.Flag{SpecFlag},
+		Action: genesis,
+	}
+)
+
+// genesis tạo ledger 0 và ghi vào ledger_path trong file cấu hình
+func genesis(c *cli.Context) error {
+	cfg, err := config.LoadConfig(c)
+	if err != nil {
+		return fmt.Errorf("load config failed: %v", err)
+	}
+
+	spec, err := ledger.LoadGenesisSpec(c.String(SpecFlag.Name))
+	if err != nil {
+		return fmt.Errorf("load genesis spec failed: %v", err)
+	}
+
+	if cfg.LedgerPath == "" {
+		// không có nơi lưu trữ, chỉ in ra hash của genesis
+		block, err := ledger.Genesis(spec)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Genesis hash: %x\n", block.Header.Hash)
+		return nil
+	}
+
+	store, err := storage.Open(cfg.LedgerPath)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	block, err := ledger.InitGenesis(store, spec)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Genesis hash: %x\n", block.Header.Hash)
+	fmt.Printf("Ledger written to %s\n", cfg.LedgerPath)
+	return nil
+}
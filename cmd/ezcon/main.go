@@ -41,6 +41,9 @@ func main() {
 			ConfigFlag,
 		},
 		Name: "ezcon",
+		Commands: []*cli.Command{
+			genesisCommand,
+		},
 		Action: func(c *cli.Context) error {
 
 			// load config
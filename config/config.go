@@ -21,6 +21,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"os"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/urfave/cli/v2"
@@ -32,8 +33,16 @@ type Config struct {
 	UNL           []string `toml:"unl"`
 	UNLPublicKey  []string `toml:"unl_public_key"`
 	LedgerPath    string   `toml:"ledger_path"`
+	GenesisFile   string   `toml:"genesis_file"`
 	RPCPort       string   `toml:"rpc_port"`
 	ConsensusPort string   `toml:"consensus_port"`
+	TxPoolSize    int      `toml:"tx_pool_size"`
+
+	// Consensus timers, zero means the consensus default
+	Heartbeat      time.Duration `toml:"heartbeat"`
+	LedgerInterval time.Duration `toml:"ledger_interval"`
+	AvalancheStep  time.Duration `toml:"avalanche_step"`
+	MaxEstablish   time.Duration `toml:"max_establish"`
 }
 
 func LoadConfig(ctx *cli.Context) (*Config, error) {
@@ -54,8 +63,15 @@ func LoadConfig(ctx *cli.Context) (*Config, error) {
 			UNL           []string `toml:"unl"`
 			UNLPublicKey  []string `toml:"unl_public_key"`
 			LedgerPath    string   `toml:"ledger_path"`
+			GenesisFile   string   `toml:"genesis_file"`
 			RPCPort       string   `toml:"rpc_port"`
 			ConsensusPort string   `toml:"consensus_port"`
+			TxPoolSize    int      `toml:"tx_pool_size"`
+
+			Heartbeat      string `toml:"heartbeat"`
+			LedgerInterval string `toml:"ledger_interval"`
+			AvalancheStep  string `toml:"avalanche_step"`
+			MaxEstablish   string `toml:"max_establish"`
 		}
 
 		_, err = toml.DecodeFile(file, &tomlCfg)
@@ -75,8 +91,31 @@ func LoadConfig(ctx *cli.Context) (*Config, error) {
 		cfg.UNL = tomlCfg.UNL
 		cfg.UNLPublicKey = tomlCfg.UNLPublicKey
 		cfg.LedgerPath = tomlCfg.LedgerPath
+		cfg.GenesisFile = tomlCfg.GenesisFile
 		cfg.RPCPort = tomlCfg.RPCPort
 		cfg.ConsensusPort = tomlCfg.ConsensusPort
+		cfg.TxPoolSize = tomlCfg.TxPoolSize
+
+		timers := []struct {
+			name  string
+			value string
+			dst   *time.Duration
+		}{
+			{"heartbeat", tomlCfg.Heartbeat, &cfg.Heartbeat},
+			{"ledger_interval", tomlCfg.LedgerInterval, &cfg.LedgerInterval},
+			{"avalanche_step", tomlCfg.AvalancheStep, &cfg.AvalancheStep},
+			{"max_establish", tomlCfg.MaxEstablish, &cfg.MaxEstablish},
+		}
+		for _, t := range timers {
+			if t.value == "" {
+				continue
+			}
+			d, err := time.ParseDuration(t.value)
+			if err != nil || d <= 0 {
+				return nil, errors.New("invalid " + t.name + " in TOML")
+			}
+			*t.dst = d
+		}
 	}
 
 	return cfg, nil
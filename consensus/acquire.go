@@ -0,0 +1,472 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package consensus
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+	"github.com/ezcon-foundation/go-ezcon/node/tcp"
+	"log"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// fetchTimeout là thời gian chờ dữ liệu ledger cho mỗi lần yêu cầu,
+// fetchAttempts là số lần yêu cầu trước khi bỏ cuộc. Mỗi yêu cầu hỏi tối đa
+// fetchBatchSize hash, và tối đa fetchInFlight yêu cầu chờ trả lời cùng lúc
+const (
+	fetchTimeout   = 5 * time.Second
+	fetchAttempts  = 3
+	fetchBatchSize = 256
+	fetchInFlight  = 4
+)
+
+var ErrFetchTimeout = errors.New("consensus: no peer returned the ledger data")
+
+// LedgerData là loại dữ liệu ledger mà node yêu cầu từ node khác
+type LedgerData uint32
+
+const (
+	LedgerHeader LedgerData = iota + 1 // header của ledger, theo hash của ledger
+	LedgerNode                         // node của cây trạng thái hoặc cây giao dịch, theo hash của node
+)
+
+func (d LedgerData) String() string {
+	switch d {
+	case LedgerHeader:
+		return "header"
+	case LedgerNode:
+		return "node"
+	}
+	return "unknown"
+}
+
+// LedgerDataFunc trả về dữ liệu ledger loại kind có hash trong kho của node
+type LedgerDataFunc func(kind LedgerData, hash []byte) ([]byte, error)
+
+// WrongLedgerFunc nhận ledger mà mạng đã xác nhận đầy đủ nhưng node không có.
+// Hàm không được chặn: việc tải ledger chạy ở goroutine khác
+type WrongLedgerFunc func(seq uint64, hash []byte)
+
+// fetches là các yêu cầu dữ liệu ledger đang chờ trả lời, theo loại và hash
+type fetches struct {
+	mutex   sync.Mutex
+	pending map[string][]chan []byte
+}
+
+func newFetches() *fetches {
+	return &fetches{pending: make(map[string][]chan []byte)}
+}
+
+func fetchKey(kind LedgerData, hash []byte) string {
+	return fmt.Sprintf("%d:%s", kind, hex.EncodeToString(hash))
+}
+
+// wait đăng ký chờ dữ liệu kind có hash
+func (f *fetches) wait(kind LedgerData, hash []byte) chan []byte {
+	ch := make(chan []byte, 1)
+	key := fetchKey(kind, hash)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.pending[key] = append(f.pending[key], ch)
+	return ch
+}
+
+// cancel bỏ việc chờ ch
+func (f *fetches) cancel(kind LedgerData, hash []byte, ch chan []byte) {
+	key := fetchKey(kind, hash)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	waiters := f.pending[key]
+	for i, w := range waiters {
+		if w == ch {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(f.pending, key)
+	} else {
+		f.pending[key] = waiters
+	}
+}
+
+// deliver chuyển dữ liệu tới mọi nơi đang chờ và cho biết có nơi nào chờ không
+func (f *fetches) deliver(kind LedgerData, hash, data []byte) bool {
+	key := fetchKey(kind, hash)
+
+	f.mutex.Lock()
+	waiters := f.pending[key]
+	delete(f.pending, key)
+	f.mutex.Unlock()
+
+	for _, ch := range waiters {
+		ch <- data
+	}
+	return len(waiters) > 0
+}
+
+// SetLedgerDataHandler đăng ký hàm đọc dữ liệu ledger để trả lời node khác
+func (c *Consensus) SetLedgerDataHandler(fn LedgerDataFunc) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onLedgerData = fn
+}
+
+// SetWrongLedgerHandler đăng ký hàm tải ledger khi node lệch khỏi mạng
+func (c *Consensus) SetWrongLedgerHandler(fn WrongLedgerFunc) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onWrongLedger = fn
+}
+
+// Resync chuyển node sang ledger closed vừa tải từ mạng. switchLedger đổi
+// ledger đã đóng của node và được gọi khi giữ c.mutex, để không vòng đồng
+// thuận nào đóng ledger trên ledger cũ giữa chừng. Node mở vòng mới cho ledger
+// tiếp theo và tham gia lại nếu closed là ledger mạng xác nhận gần nhất
+func (c *Consensus) Resync(closed *block.Block, switchLedger func() error) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := switchLedger(); err != nil {
+		return err
+	}
+	from := c.round.phase
+	c.setLedger(closed)
+	c.round.reset(time.Now())
+	c.logTransition(from, fmt.Sprintf("switched to acquired ledger %d", closed.Header.Index))
+
+	if c.validations.validatedHash != nil {
+		c.checkSync(c.validations.validated, c.validations.validatedHash)
+	}
+	return nil
+}
+
+// FetchLedgerData yêu cầu các dữ liệu ledger loại kind có hash trong hashes
+// từ các node trong UNL và trả dữ liệu theo thứ tự của hashes. Các hash được
+// chia thành lô fetchBatchSize hash, mỗi lô một yêu cầu, với tối đa
+// fetchInFlight yêu cầu chờ trả lời cùng lúc. Caller không được giữ c.mutex
+func (c *Consensus) FetchLedgerData(kind LedgerData, hashes [][]byte) ([][]byte, error) {
+	results := make([][]byte, len(hashes))
+	errs := make(chan error, (len(hashes)+fetchBatchSize-1)/fetchBatchSize)
+	inFlight := make(chan struct{}, fetchInFlight)
+	var wg sync.WaitGroup
+
+	for start := 0; start < len(hashes); start += fetchBatchSize {
+		end := min(start+fetchBatchSize, len(hashes))
+		inFlight <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-inFlight }()
+			if err := c.fetchBatch(kind, hashes[start:end], results[start:end]); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// fetchBatch yêu cầu một lô hash và ghi dữ liệu nhận được vào results. Các
+// hash chưa có câu trả lời sau fetchTimeout được yêu cầu lại
+func (c *Consensus) fetchBatch(kind LedgerData, hashes, results [][]byte) error {
+	waits := make([]chan []byte, len(hashes))
+	for i, hash := range hashes {
+		waits[i] = c.fetches.wait(kind, hash)
+	}
+	defer func() {
+		for i, hash := range hashes {
+			if results[i] == nil {
+				c.fetches.cancel(kind, hash, waits[i])
+			}
+		}
+	}()
+
+	missing := make([]int, len(hashes))
+	for i := range missing {
+		missing[i] = i
+	}
+	for attempt := 0; attempt < fetchAttempts && len(missing) > 0; attempt++ {
+		request := make([][]byte, len(missing))
+		for j, i := range missing {
+			request[j] = hashes[i]
+		}
+		payload, err := ledgerRequestPayload(kind, request, c.port)
+		if err != nil {
+			return err
+		}
+		c.mutex.Lock()
+		msg, err := c.newMessage(tcp.MessageLedgerRequest, c.ledgerSeq, payload)
+		c.mutex.Unlock()
+		if err != nil {
+			return err
+		}
+		if err := c.Broadcast(msg); err != nil {
+			return err
+		}
+
+		// chờ tới hết fetchTimeout, rồi lấy nốt những gì đã tới
+		timer := time.NewTimer(fetchTimeout)
+		expired := false
+		left := missing[:0]
+		for _, i := range missing {
+			if !expired {
+				select {
+				case results[i] = <-waits[i]:
+					continue
+				case <-timer.C:
+					expired = true
+				}
+			}
+			select {
+			case results[i] = <-waits[i]:
+			default:
+				left = append(left, i)
+			}
+		}
+		timer.Stop()
+		missing = left
+		if len(missing) > 0 {
+			log.Printf("%d ledger %v not received, attempt %d", len(missing), kind, attempt+1)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %d %v, first %x", ErrFetchTimeout, len(missing), kind, hashes[missing[0]])
+	}
+	return nil
+}
+
+// handleLedgerRequest trả dữ liệu ledger trong kho cho node yêu cầu. Câu trả
+// lời chỉ được gửi về node yêu cầu, tới cổng trong yêu cầu trên địa chỉ mà
+// yêu cầu đến từ đó
+func (c *Consensus) handleLedgerRequest(msg tcp.Message) {
+	c.mutex.Lock()
+	ok := c.checkMessage(msg)
+	serve := c.onLedgerData
+	c.mutex.Unlock()
+	if !ok || serve == nil {
+		return
+	}
+
+	kind, hashes, port, err := readLedgerRequestPayload(msg.Payload)
+	if err != nil {
+		log.Printf("Invalid ledger request from %v: %v", msg.Sender, err)
+		return
+	}
+	host, _, err := net.SplitHostPort(msg.From)
+	if err != nil {
+		log.Printf("Ledger request from %v without a reply address: %v", msg.Sender, err)
+		return
+	}
+
+	// dữ liệu không có trong kho được bỏ qua, node yêu cầu sẽ hỏi lại
+	var items [][]byte
+	for _, hash := range hashes {
+		data, err := serve(kind, hash)
+		if err != nil {
+			log.Printf("Ledger %v %x requested by %v not available: %v", kind, hash, msg.Sender, err)
+			continue
+		}
+		item, err := ledgerItemPayload(hash, data)
+		if err != nil {
+			log.Println("can not encode ledger data", err)
+			return
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	payload, err := ledgerDataPayload(kind, items)
+	if err != nil {
+		log.Println("can not encode ledger data", err)
+		return
+	}
+	c.mutex.Lock()
+	reply, err := c.newMessage(tcp.MessageLedgerData, c.ledgerSeq, payload)
+	c.mutex.Unlock()
+	if err != nil {
+		log.Println("can not sign ledger data", err)
+		return
+	}
+	addr := net.JoinHostPort(host, strconv.FormatUint(uint64(port), 10))
+	if err := c.client.Send(addr, reply); err != nil {
+		log.Printf("Send ledger data to %v failed: %v", addr, err)
+	}
+}
+
+// handleLedgerData kiểm tra dữ liệu ledger nhận được với hash đã yêu cầu rồi
+// chuyển cho nơi đang chờ
+func (c *Consensus) handleLedgerData(msg tcp.Message) {
+	c.mutex.Lock()
+	ok := c.checkMessage(msg)
+	c.mutex.Unlock()
+	if !ok {
+		return
+	}
+
+	kind, items, err := readLedgerDataPayload(msg.Payload)
+	if err != nil {
+		log.Printf("Invalid ledger data from %v: %v", msg.Sender, err)
+		return
+	}
+	for _, item := range items {
+		hash, data, err := readLedgerItemPayload(item)
+		if err != nil {
+			log.Printf("Invalid ledger data from %v: %v", msg.Sender, err)
+			return
+		}
+		if err := verifyLedgerData(kind, hash, data); err != nil {
+			log.Printf("Ledger %v %x from %v rejected: %v", kind, hash, msg.Sender, err)
+			continue
+		}
+		c.fetches.deliver(kind, hash, data)
+	}
+}
+
+// verifyLedgerData kiểm tra data đúng là dữ liệu loại kind có hash
+func verifyLedgerData(kind LedgerData, hash, data []byte) error {
+	switch kind {
+	case LedgerHeader:
+		var header block.BlockHeader
+		if err := header.UnmarshalBinary(data); err != nil {
+			return err
+		}
+		if !bytes.Equal(header.Hash, hash) || !bytes.Equal(header.ComputeHash(), hash) {
+			return errors.New("header hash mismatch")
+		}
+		return nil
+	case LedgerNode:
+		got, err := block.NodeHash(data)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(got, hash) {
+			return errors.New("node hash mismatch")
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown ledger data %d", kind)
+}
+
+// ledgerRequestPayload mã hoá yêu cầu dữ liệu ledger, port là cổng TCP của
+// node yêu cầu
+func ledgerRequestPayload(kind LedgerData, hashes [][]byte, port uint32) ([]byte, error) {
+	e := codec.NewEncoder()
+	e.Uint32(codec.FieldDataType, uint32(kind))
+	e.Uint32(codec.FieldPort, port)
+	e.Array(codec.FieldHashes, hashes)
+	return e.Bytes()
+}
+
+// readLedgerRequestPayload giải mã yêu cầu dữ liệu ledger
+func readLedgerRequestPayload(payload []byte) (kind LedgerData, hashes [][]byte, port uint32, err error) {
+	d := codec.NewDecoder(payload)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldDataType:
+			kind = LedgerData(d.Uint32())
+		case codec.FieldPort:
+			port = d.Uint32()
+		case codec.FieldHashes:
+			hashes = d.Array()
+		default:
+			d.Skip()
+		}
+	}
+	if err := d.Err(); err != nil {
+		return 0, nil, 0, err
+	}
+	if len(hashes) == 0 || len(hashes) > fetchBatchSize || port == 0 {
+		return 0, nil, 0, errors.New("ledger request without hashes or port")
+	}
+	return kind, hashes, port, nil
+}
+
+// ledgerDataPayload mã hoá câu trả lời dữ liệu ledger, mỗi item là một hash
+// cùng dữ liệu của nó
+func ledgerDataPayload(kind LedgerData, items [][]byte) ([]byte, error) {
+	e := codec.NewEncoder()
+	e.Uint32(codec.FieldDataType, uint32(kind))
+	e.Array(codec.FieldLedgerData, items)
+	return e.Bytes()
+}
+
+// readLedgerDataPayload giải mã câu trả lời dữ liệu ledger
+func readLedgerDataPayload(payload []byte) (kind LedgerData, items [][]byte, err error) {
+	d := codec.NewDecoder(payload)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldDataType:
+			kind = LedgerData(d.Uint32())
+		case codec.FieldLedgerData:
+			items = d.Array()
+		default:
+			d.Skip()
+		}
+	}
+	if err := d.Err(); err != nil {
+		return 0, nil, err
+	}
+	return kind, items, nil
+}
+
+// ledgerItemPayload mã hoá một dữ liệu ledger cùng hash của nó
+func ledgerItemPayload(hash, data []byte) ([]byte, error) {
+	e := codec.NewEncoder()
+	e.Blob(codec.FieldHash, hash)
+	e.Blob(codec.FieldPayload, data)
+	return e.Bytes()
+}
+
+// readLedgerItemPayload giải mã một dữ liệu ledger
+func readLedgerItemPayload(item []byte) (hash, data []byte, err error) {
+	d := codec.NewDecoder(item)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldHash:
+			hash = d.Blob()
+		case codec.FieldPayload:
+			data = d.Blob()
+		default:
+			d.Skip()
+		}
+	}
+	if err := d.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(hash) == 0 {
+		return nil, nil, errors.New("ledger data without hash")
+	}
+	return hash, data, nil
+}
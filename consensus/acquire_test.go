@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package consensus
+
+import (
+	"bytes"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"testing"
+	"time"
+)
+
+func TestLedgerDataPayload(t *testing.T) {
+	item, err := ledgerItemPayload([]byte{1, 2}, []byte{3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := ledgerDataPayload(LedgerNode, [][]byte{item, item})
+	if err != nil {
+		t.Fatal(err)
+	}
+	kind, items, err := readLedgerDataPayload(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kind != LedgerNode || len(items) != 2 {
+		t.Fatalf("got %v with %d items", kind, len(items))
+	}
+	hash, data, err := readLedgerItemPayload(items[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(hash, []byte{1, 2}) || !bytes.Equal(data, []byte{3}) {
+		t.Fatalf("got %x %x", hash, data)
+	}
+}
+
+func TestLedgerRequestPayload(t *testing.T) {
+	hashes := [][]byte{{1, 2}, {3}}
+	payload, err := ledgerRequestPayload(LedgerNode, hashes, 5001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kind, got, port, err := readLedgerRequestPayload(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kind != LedgerNode || len(got) != 2 || !bytes.Equal(got[0], hashes[0]) || !bytes.Equal(got[1], hashes[1]) || port != 5001 {
+		t.Fatalf("got %v %x %d", kind, got, port)
+	}
+
+	// yêu cầu không có cổng thì không trả lời được
+	payload, _ = ledgerRequestPayload(LedgerNode, hashes, 0)
+	if _, _, _, err := readLedgerRequestPayload(payload); err == nil {
+		t.Fatal("request without a port accepted")
+	}
+}
+
+func TestVerifyLedgerData(t *testing.T) {
+	b := block.NewBlock(3, []byte{1}, 100)
+	b.Accounts.Set(block.AccountKey("alice"), []byte("alice"))
+	b.UpdateRoots()
+	b.Header.Hash = b.Header.ComputeHash()
+	header, err := b.Header.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyLedgerData(LedgerHeader, b.Header.Hash, header); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyLedgerData(LedgerHeader, []byte{9}, header); err == nil {
+		t.Fatal("header accepted for another hash")
+	}
+
+	err = b.Accounts.WalkNodes(nil, func(hash, blob []byte) error {
+		if err := verifyLedgerData(LedgerNode, hash, blob); err != nil {
+			return err
+		}
+		if verifyLedgerData(LedgerNode, b.Header.Hash, blob) == nil {
+			t.Error("node accepted for another hash")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFetchDeliver(t *testing.T) {
+	f := newFetches()
+	hash := []byte{1}
+	ch := f.wait(LedgerNode, hash)
+	if f.deliver(LedgerHeader, hash, []byte{2}) {
+		t.Fatal("delivered to a request for another kind")
+	}
+	if !f.deliver(LedgerNode, hash, []byte{2}) {
+		t.Fatal("request not found")
+	}
+	if data := <-ch; !bytes.Equal(data, []byte{2}) {
+		t.Fatalf("got %x", data)
+	}
+
+	// câu trả lời đến sau khi đã bỏ chờ bị bỏ qua
+	ch = f.wait(LedgerNode, hash)
+	f.cancel(LedgerNode, hash, ch)
+	if f.deliver(LedgerNode, hash, []byte{2}) {
+		t.Fatal("delivered to a cancelled request")
+	}
+}
+
+func TestCheckSync(t *testing.T) {
+	var wrong []uint64
+	c := &Consensus{
+		ledgerSeq:  5,
+		closedHash: []byte{4},
+		round:      newRound(0.8, 3, avalancheStep),
+		onWrongLedger: func(seq uint64, hash []byte) {
+			wrong = append(wrong, seq)
+		},
+	}
+	c.round.establish(testPosition(t, testNow, 1), testNow, true)
+
+	// mạng xác nhận ledger 5 trước khi node đóng xong: chưa lệch
+	c.checkSync(5, []byte{5})
+	if c.mode != ModeProposing || len(wrong) != 0 {
+		t.Fatal("bowed out before closing the ledger")
+	}
+
+	// mạng xác nhận ledger 4 khác ledger node đã đóng
+	c.checkSync(4, []byte{9})
+	if c.mode != ModeObserving || c.round.proposing || len(wrong) != 1 || wrong[0] != 4 {
+		t.Fatalf("mode %v, wrong ledgers %v", c.mode, wrong)
+	}
+
+	// sau khi tải đúng ledger, node tham gia lại
+	c.setLedger(&block.Block{Header: block.BlockHeader{Index: 6, Hash: []byte{6}}})
+	c.round.reset(testNow.Add(time.Second))
+	c.checkSync(6, []byte{6})
+	if c.mode != ModeProposing {
+		t.Fatal("node did not rejoin")
+	}
+}
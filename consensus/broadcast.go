@@ -18,25 +18,14 @@
 package consensus
 
 import (
-	"encoding/json"
 	"errors"
-	"github.com/ezcon-foundation/go-ezcon/core/transaction"
 	"github.com/ezcon-foundation/go-ezcon/node/tcp"
 	"log"
 	"sync"
 )
 
-// Broadcast gửi candidate set đến UNL
-func (c *Consensus) Broadcast(txs []*transaction.Transaction, sig []byte) error {
-
-	// json marshal all transaction
-	data, err := json.Marshal(txs)
-	if err != nil {
-		return err
-	}
-
-	// create message
-	msg := tcp.Message{Txs: data, Sig: sig}
+// Broadcast gửi message đến UNL
+func (c *Consensus) Broadcast(msg tcp.Message) error {
 
 	var wg sync.WaitGroup
 	errChan := make(chan error, len(c.UNL))
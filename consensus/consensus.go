@@ -18,15 +18,28 @@
 package consensus
 
 import (
+	"errors"
+	"fmt"
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
 	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"github.com/ezcon-foundation/go-ezcon/core/txpool"
+	"github.com/ezcon-foundation/go-ezcon/crypto"
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
 	"github.com/ezcon-foundation/go-ezcon/node/tcp"
+	"log"
+	"strconv"
 	"sync"
 	"time"
 )
 
+// AcceptFunc đóng ledger tiếp theo từ tập giao dịch đã được đồng thuận và trả về ledger đã đóng
+type AcceptFunc func(txs []transaction.Transaction, closeTime time.Time) (*block.Block, error)
+
 type Consensus struct {
-	Transactions        []*transaction.Transaction
-	proposalTransaction []*transaction.Transaction
+	// Pool chứa các giao dịch đang chờ được đưa vào ledger
+	Pool *txpool.Pool
 
 	UNL          []string
 	UNLPublicKey []string
@@ -34,23 +47,92 @@ type Consensus struct {
 	PrivKey      []byte
 	Threshold    float64 // 0.8
 
+	// Timing là các mốc thời gian của vòng đồng thuận
+	Timing Timing
+
+	// key là khoá ký của node, sinh từ seed PrivKey; publicKey là public key
+	// đã mã hoá của node, như trong unl_public_key
+	key       crypto.PrivateKey
+	publicKey string
+
 	// tcp server
 	server *tcp.TCPServer
 	client *tcp.TCPClient
 
-	isConsensing bool
-	mutex        sync.Mutex
+	// port là cổng TCP của node, gửi kèm yêu cầu dữ liệu ledger để node trả
+	// lời gửi dữ liệu về đúng node yêu cầu
+	port uint32
+
+	// networkID là mạng của ledger, ledgerSeq là ledger đang được đồng thuận
+	// và closedHash là hash của ledger đóng trước nó. Message của mạng khác
+	// hoặc ledger khác bị bỏ qua
+	networkID  uint32
+	ledgerSeq  uint64
+	closedHash []byte
+
+	// mode cho biết node đang đề xuất hay chỉ quan sát vì lệch khỏi mạng.
+	// outsider cho biết node không có trong UNL của genesis nên luôn chỉ
+	// quan sát
+	mode     Mode
+	outsider bool
+
+	// round là trạng thái vòng đồng thuận hiện tại
+	round *round
+	mutex sync.Mutex
 
 	proposalChan <-chan tcp.Message // Kênh cho đề xuất
-	voteChan     <-chan tcp.Message // Kênh cho phiếu bầu
+	voteChan     <-chan tcp.Message // Kênh cho vị trí cập nhật trong lúc establish
+	relayChan    <-chan tcp.Message // Kênh cho giao dịch được chuyển tiếp
+
+	// validations gom xác nhận ledger của các validator, onValidated nhận
+	// ledger được xác nhận đầy đủ
+	validationChan <-chan tcp.Message
+	validations    *validations
+	onValidated    ValidatedFunc
+
+	// onAccept nhận tập giao dịch đã đồng thuận để đóng ledger
+	onAccept AcceptFunc
+
+	// ledgerRequestChan và ledgerDataChan nhận yêu cầu và câu trả lời dữ
+	// liệu ledger; fetches là các yêu cầu của node đang chờ trả lời
+	ledgerRequestChan <-chan tcp.Message
+	ledgerDataChan    <-chan tcp.Message
+	fetches           *fetches
+
+	// onLedgerData đọc dữ liệu ledger cho node khác, onWrongLedger tải
+	// ledger mạng đã xác nhận khi node lệch khỏi mạng
+	onLedgerData  LedgerDataFunc
+	onWrongLedger WrongLedgerFunc
 }
 
-func NewConsensus(unl, unlPublicKey []string, nodeID string, privKey []byte, tpcPort string) *Consensus {
+// NewConsensus tạo consensus với khoá ký sinh từ seed privKey và mở TCP server
+// trên cổng tpcPort. Lỗi được trả về khi seed không hợp lệ hoặc không mở được
+// cổng
+func NewConsensus(unl, unlPublicKey []string, nodeID string, privKey []byte, tpcPort string, pool *txpool.Pool, timing Timing) (*Consensus, error) {
+
+	// sinh khoá ký của node từ private key trong cấu hình
+	pub, key, err := keys.FromSeed(keys.DefaultScheme, privKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node private key: %w", err)
+	}
+
+	// in ra public key của node để các node khác thêm vào unl_public_key
+	pubKey, err := keys.MarshalPublicKey(pub)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node public key: %w", err)
+	}
+	publicKey := address.EncodeNodePublicKey(pubKey)
+	log.Printf("Node public key: %s", publicKey)
+
+	port, err := strconv.ParseUint(tpcPort, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid consensus port %q: %w", tpcPort, err)
+	}
 
 	// create tcp server
 	server, err := tcp.NewTCPServer(tpcPort)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
 	// create tcp client
@@ -59,43 +141,275 @@ func NewConsensus(unl, unlPublicKey []string, nodeID string, privKey []byte, tpc
 	// khởi tạo channel, giới hạn 100 giao dịch
 	proposalChan := make(chan tcp.Message, 100)
 	voteChan := make(chan tcp.Message, 100)
+	relayChan := make(chan tcp.Message, 100)
+	validationChan := make(chan tcp.Message, 100)
+	ledgerRequestChan := make(chan tcp.Message, 100)
+	ledgerDataChan := make(chan tcp.Message, 100)
 
 	// init consensus instance
 	c := &Consensus{
+		Pool:         pool,
 		UNL:          unl,
 		UNLPublicKey: unlPublicKey,
 		NodeID:       nodeID,
 		PrivKey:      privKey,
 		Threshold:    0.8,
+		Timing:       timing.withDefaults(),
+		key:          key,
+		publicKey:    publicKey,
 		server:       server,
 		client:       client,
+		port:         uint32(port),
 		proposalChan: proposalChan,
 		voteChan:     voteChan,
-		isConsensing: false,
+		relayChan:    relayChan,
+
+		validationChan: validationChan,
+
+		ledgerRequestChan: ledgerRequestChan,
+		ledgerDataChan:    ledgerDataChan,
+		fetches:           newFetches(),
 	}
+	c.round = newRound(c.Threshold, c.validators(), c.Timing.AvalancheStep)
+	c.validations = newValidations(c.Threshold, c.validators())
 
-	// start tcp server
-	go c.server.Start(c.IsConsensing, proposalChan, voteChan)
+	// start tcp server, message được chuyển tới kênh theo loại
+	server.Route(tcp.MessageProposal, proposalChan)
+	server.Route(tcp.MessagePositionUpdate, voteChan)
+	server.Route(tcp.MessageTxRelay, relayChan)
+	server.Route(tcp.MessageValidation, validationChan)
+	server.Route(tcp.MessageLedgerRequest, ledgerRequestChan)
+	server.Route(tcp.MessageLedgerData, ledgerDataChan)
+	go c.server.Start()
 
-	return c
+	return c, nil
 }
 
-func (c *Consensus) getProposalTransaction() []*transaction.Transaction {
+func (c *Consensus) getProposalTransaction() []transaction.Transaction {
 
-	// todo: choose available transaction for proposal
-	return c.Transactions
+	// đề xuất các giao dịch đang chờ trong pool, phí cao trước
+	return c.Pool.Transactions()
 }
 
-func (c *Consensus) saveProposalTransaction(txs []*transaction.Transaction) {
+// SetLedger đặt ledger đã đóng gần nhất, consensus đồng thuận ledger tiếp theo
+func (c *Consensus) SetLedger(closed *block.Block) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.setLedger(closed)
+}
+
+// setLedger đặt ledger đã đóng gần nhất. Caller phải giữ c.mutex
+func (c *Consensus) setLedger(closed *block.Block) {
+	if closed == nil {
+		return
+	}
+	c.ledgerSeq = closed.Header.Index + 1
+	c.closedHash = closed.Header.Hash
 
-	for _, tx := range txs {
-		c.proposalTransaction = append(c.proposalTransaction, tx)
+	params, err := state.New(&closed.Accounts).Params()
+	if err != nil && !errors.Is(err, state.ErrParamsNotFound) {
+		log.Printf("Can not load network parameters: %v", err)
+		return
+	}
+	if params == nil {
+		return
+	}
+	c.networkID = params.NetworkID
+
+	// UNL trong genesis thay cho UNLPublicKey của cấu hình, để mọi node dùng
+	// cùng một tập validator. Node không có trong UNL đó không được tính là
+	// validator và chỉ quan sát
+	if len(params.UNL) > 0 {
+		c.UNLPublicKey = params.UNL
+		c.outsider = !c.isValidator(c.publicKey)
+		if c.outsider {
+			c.bowOut("node is not in the genesis UNL")
+		}
+		c.round.validators = c.validators()
+		c.validations.validators = c.validators()
 	}
 }
 
-// IsConsensing trả về trạng thái đồng thuận
-func (c *Consensus) IsConsensing() bool {
+// newMessage tạo và ký message loại t cho ledger seq
+func (c *Consensus) newMessage(t tcp.MessageType, seq uint64, payload []byte) (tcp.Message, error) {
+	msg := tcp.Message{
+		Type:      t,
+		Version:   tcp.ProtocolVersion,
+		NetworkID: c.networkID,
+		LedgerSeq: seq,
+		Round:     c.round.attempt,
+		Sender:    c.publicKey,
+		Payload:   payload,
+	}
+	if err := msg.Sign(c.key); err != nil {
+		return tcp.Message{}, err
+	}
+	return msg, nil
+}
+
+// checkMessage kiểm tra message đến từ một node khác trong UNL, cùng mạng,
+// và được ký bởi node đó
+func (c *Consensus) checkMessage(msg tcp.Message) bool {
+	if msg.NetworkID != c.networkID {
+		log.Printf("Message from %v for network %d, dropping", msg.Sender, msg.NetworkID)
+		return false
+	}
+	if msg.Sender == c.publicKey || !c.isValidator(msg.Sender) {
+		log.Printf("Message from unknown node %v, dropping", msg.Sender)
+		return false
+	}
+	if !msg.Verify() {
+		log.Printf("Invalid signature from %v, dropping", msg.Sender)
+		return false
+	}
+	return true
+}
+
+// isValidator cho biết node có trong UNLPublicKey
+func (c *Consensus) isValidator(node string) bool {
+	for _, key := range c.UNLPublicKey {
+		if key == node {
+			return true
+		}
+	}
+	return false
+}
+
+// validators trả về số validator của mạng: các node trong UNLPublicKey và
+// chính node này, trừ khi node nằm ngoài UNL của genesis
+func (c *Consensus) validators() int {
+	if c.outsider || c.isValidator(c.publicKey) {
+		return len(c.UNLPublicKey)
+	}
+	return len(c.UNLPublicKey) + 1
+}
+
+// SetAcceptHandler đăng ký hàm đóng ledger khi vòng đồng thuận kết thúc
+func (c *Consensus) SetAcceptHandler(fn AcceptFunc) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onAccept = fn
+}
+
+// heartbeat chạy theo nhịp Timing.Heartbeat: node đề xuất khi ledger đã mở đủ
+// LedgerInterval, bỏ phiếu lại trong lúc establish và bỏ vòng quá
+// MaxEstablish. Caller phải giữ c.mutex
+func (c *Consensus) heartbeat(now time.Time) {
+	switch c.round.phase {
+	case PhaseOpen:
+		if now.Sub(c.round.openedAt) >= c.Timing.LedgerInterval {
+			c.startRound(now)
+		}
+	case PhaseEstablish:
+		if now.Sub(c.round.startedAt) >= c.Timing.MaxEstablish {
+			c.abandonRound(now, "no consensus within the maximum establish time")
+			return
+		}
+
+		// ngưỡng bỏ phiếu tăng theo thời gian establish
+		c.updateRound(now)
+	}
+}
+
+// startRound đóng ledger đang mở: node lấy các giao dịch trong pool làm vị trí
+// ban đầu, gửi cho các validator khác nếu đang đề xuất và bắt đầu establish.
+// Caller phải giữ c.mutex
+func (c *Consensus) startRound(now time.Time) {
+	ours, err := newPosition(c.getProposalTransaction(), now)
+	if err != nil {
+		log.Println("can not build proposal", err)
+		return
+	}
+	c.round.establish(ours, now, c.mode == ModeProposing)
+	c.logTransition(PhaseOpen, fmt.Sprintf("%d transactions", len(ours.txs)))
+
+	if c.mode == ModeProposing {
+		c.broadcastPosition()
+	}
+	c.updateRound(now)
+}
+
+// updateRound bỏ phiếu lại theo các vị trí đã nhận. Vị trí mới của node được
+// gửi lại cho các validator khác, và tập giao dịch đã thống nhất được chuyển
+// sang bước đóng ledger. Caller phải giữ c.mutex
+func (c *Consensus) updateRound(now time.Time) {
+	changed, agreed := c.round.update(now)
+	if changed {
+		log.Printf("Position changed to %d transactions", len(c.round.ours.txs))
+		if c.mode == ModeProposing {
+			c.broadcastPosition()
+		}
+	}
+	if agreed {
+		c.logTransition(PhaseEstablish, fmt.Sprintf("%d transactions agreed", len(c.round.ours.txs)))
+		c.accept(c.round.ours.transactions(), c.round.ours.closeTime, now)
+	}
+}
+
+// abandonRound bỏ vòng hiện tại mà không đóng ledger và mở lại ledger cho
+// lần thử tiếp theo, với vị trí các validator đã gửi cho lần thử đó. Các giao
+// dịch vẫn nằm trong pool cho vòng tiếp theo. Caller phải giữ c.mutex
+func (c *Consensus) abandonRound(now time.Time, reason string) {
+	from := c.round.phase
+	ahead := c.round.ahead
+	c.round.restart(c.round.attempt+1, now)
+	c.round.peers = ahead
+	c.logTransition(from, "round abandoned: "+reason)
+}
+
+// logTransition ghi lại việc vòng chuyển từ giai đoạn from sang giai đoạn hiện tại
+func (c *Consensus) logTransition(from Phase, reason string) {
+	log.Printf("Consensus ledger %d round %d: %v -> %v (%s)",
+		c.ledgerSeq, c.round.attempt, from, c.round.phase, reason)
+}
+
+// broadcastPosition gửi vị trí hiện tại của node cho các node trong UNL.
+// Caller phải giữ c.mutex
+func (c *Consensus) broadcastPosition() {
+	msg, err := c.positionMessage(c.round.ours)
+	if err != nil {
+		log.Println("can not encode position", err)
+		return
+	}
+	if err := c.Broadcast(msg); err != nil {
+		log.Printf("Broadcast position failed: %v", err)
+	}
+}
+
+// accept chuyển tập giao dịch đã đồng thuận sang bước đóng ledger và kết thúc
+// vòng đồng thuận. Caller phải giữ c.mutex
+func (c *Consensus) accept(txs []transaction.Transaction, closeTime, now time.Time) {
+	if c.onAccept != nil {
+		closed, err := c.onAccept(txs, closeTime)
+		if err != nil {
+			log.Printf("Close ledger failed: %v", err)
+		} else {
+			c.setLedger(closed)
+
+			// mạng có thể đã xác nhận ledger này trước khi node đóng xong
+			if c.validations.validated == closed.Header.Index {
+				c.checkSync(c.validations.validated, c.validations.validatedHash)
+			}
+
+			// node đang quan sát không xác nhận ledger của nó
+			if c.mode == ModeProposing {
+				c.validate(closed)
+			}
+		}
+	}
+
+	c.round.reset(now)
+	c.logTransition(PhaseAccepted, "ledger closed")
+}
+
+// Phase trả về giai đoạn của vòng đồng thuận hiện tại
+func (c *Consensus) Phase() Phase {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	return c.isConsensing
+	return c.round.phase
+}
+
+// IsConsensing cho biết node đang trong một vòng đồng thuận
+func (c *Consensus) IsConsensing() bool {
+	return c.Phase() != PhaseOpen
 }
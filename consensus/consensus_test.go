@@ -0,0 +1,190 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package consensus
+
+import (
+	"errors"
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"github.com/ezcon-foundation/go-ezcon/core/txpool"
+	"github.com/ezcon-foundation/go-ezcon/crypto"
+	"github.com/ezcon-foundation/go-ezcon/internal/testkey"
+	"github.com/ezcon-foundation/go-ezcon/node/tcp"
+	"math"
+	"testing"
+	"time"
+)
+
+// testValidator sinh khoá ký và public key của validator name
+func testValidator(t *testing.T, name string) (crypto.PrivateKey, string) {
+	t.Helper()
+	key, pubKey := testkey.New(t, name)
+	return key, address.EncodeNodePublicKey(pubKey)
+}
+
+// testConsensus trả về consensus của validator "self" trên ledger 1, với UNL
+// gồm self và các validator names
+func testConsensus(t *testing.T, names ...string) *Consensus {
+	t.Helper()
+	key, self := testValidator(t, "self")
+	c := &Consensus{
+		Pool:         txpool.New(0),
+		UNLPublicKey: []string{self},
+		Threshold:    0.8,
+		key:          key,
+		publicKey:    self,
+		ledgerSeq:    1,
+	}
+	for _, name := range names {
+		_, pub := testValidator(t, name)
+		c.UNLPublicKey = append(c.UNLPublicKey, pub)
+	}
+	c.round = newRound(c.Threshold, c.validators(), avalancheStep)
+	c.validations = newValidations(c.Threshold, c.validators())
+	return c
+}
+
+// testPositionMessage trả về proposal của validator name cho lần thử round
+func testPositionMessage(t *testing.T, name string, round uint32, payload []byte) tcp.Message {
+	t.Helper()
+	key, pub := testValidator(t, name)
+	msg := tcp.Message{
+		Type:      tcp.MessageProposal,
+		Version:   tcp.ProtocolVersion,
+		LedgerSeq: 1,
+		Round:     round,
+		Sender:    pub,
+		Payload:   payload,
+	}
+	if err := msg.Sign(key); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+func TestNewConsensusErrors(t *testing.T) {
+	// seed sai độ dài thì không tạo được khoá ký
+	if c, err := NewConsensus(nil, nil, "", []byte{1}, "0", txpool.New(0), Timing{}); !errors.Is(err, crypto.ErrSeedSize) || c != nil {
+		t.Fatalf("got %v, want %v", err, crypto.ErrSeedSize)
+	}
+
+	seed := make([]byte, 32)
+	if _, err := NewConsensus(nil, nil, "", seed, "port", txpool.New(0), Timing{}); err == nil {
+		t.Fatal("invalid port accepted")
+	}
+}
+
+// testGenesis trả về ledger 0 của mạng 7 với UNL unl
+func testGenesis(t *testing.T, unl ...string) *block.Block {
+	t.Helper()
+	genesis := block.NewBlock(0, nil, 1000)
+	genesis.Header.Hash = []byte{0}
+	st := state.New(&genesis.Accounts)
+	if err := st.SetParams(&state.Params{NetworkID: 7, UNL: unl}); err != nil {
+		t.Fatal(err)
+	}
+	genesis.Accounts = *st.Tree()
+	return genesis
+}
+
+func TestSetLedgerGenesisUNL(t *testing.T) {
+	c := &Consensus{
+		UNLPublicKey: []string{"config"},
+		publicKey:    "self",
+		round:        newRound(0.8, 2, 0),
+		validations:  newValidations(0.8, 2),
+	}
+
+	// UNL của genesis thay cho cấu hình, node đã có trong UNL nên có 3 validator
+	c.setLedger(testGenesis(t, "a", "b", "self"))
+	if len(c.UNLPublicKey) != 3 || c.isValidator("config") {
+		t.Fatalf("UNLPublicKey = %v, want the genesis UNL", c.UNLPublicKey)
+	}
+	if c.networkID != 7 || c.round.validators != 3 || c.validations.validators != 3 {
+		t.Fatalf("networkID %d, validators %d/%d", c.networkID, c.round.validators, c.validations.validators)
+	}
+	if c.mode != ModeProposing {
+		t.Fatal("validator of the genesis UNL does not propose")
+	}
+}
+
+func TestSetLedgerOutsideGenesisUNL(t *testing.T) {
+	c := &Consensus{
+		publicKey:   "self",
+		round:       newRound(0.8, 1, 0),
+		validations: newValidations(0.8, 1),
+	}
+
+	// node ngoài UNL không tự tính mình: 4 validator, cần đủ 4 xác nhận
+	closed := testGenesis(t, "a", "b", "c", "d")
+	closed.Header.Index = 1
+	c.setLedger(closed)
+	if c.mode != ModeObserving || c.round.validators != 4 || c.validations.validators != 4 {
+		t.Fatalf("mode %v, validators %d/%d", c.mode, c.round.validators, c.validations.validators)
+	}
+	for _, node := range []string{"a", "b", "c"} {
+		c.addValidation(node, 1, []byte{0})
+	}
+	if c.validations.validated != 0 {
+		t.Fatal("ledger validated by 3 of 4 validators")
+	}
+
+	// ledger của node trùng ledger mạng đã xác nhận nhưng node vẫn quan sát
+	c.addValidation("d", 1, []byte{0})
+	if c.validations.validated != 1 || c.mode != ModeObserving {
+		t.Fatalf("validated %d, mode %v", c.validations.validated, c.mode)
+	}
+}
+
+func TestFollowRound(t *testing.T) {
+	c := testConsensus(t, "a", "b", "c", "d")
+	c.mode = ModeObserving
+	payload, err := testPosition(t, testNow, 1).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// một validator gửi lần thử ngày càng cao không làm node bỏ vòng
+	for _, round := range []uint32{1, 2, 3, math.MaxUint32, 1} {
+		c.handleProposal(testPositionMessage(t, "a", round, payload))
+	}
+	c.handleProposal(testPositionMessage(t, "b", 1, []byte{0xff}))
+	if c.round.attempt != 0 || len(c.round.peers) != 0 {
+		t.Fatalf("moved to round %d with %d positions", c.round.attempt, len(c.round.peers))
+	}
+
+	// 4 trên 5 validator đã sang lần thử 1: node theo, giữ vị trí của họ và
+	// đồng thuận ngay với họ
+	var accepted []uint32
+	c.onAccept = func(txs []transaction.Transaction, closeTime time.Time) (*block.Block, error) {
+		accepted = append(accepted, c.round.attempt)
+		return nil, errors.New("not closing")
+	}
+	for _, name := range []string{"b", "c"} {
+		c.handleProposal(testPositionMessage(t, name, 1, payload))
+	}
+	if c.round.attempt != 0 {
+		t.Fatal("moved with 3 of 5 validators")
+	}
+	c.handleProposal(testPositionMessage(t, "d", 1, payload))
+	if len(accepted) != 1 || accepted[0] != 1 {
+		t.Fatalf("accepted in rounds %v", accepted)
+	}
+}
@@ -18,14 +18,11 @@
 package consensus
 
 import (
-	"encoding/json"
-	"github.com/ezcon-foundation/go-ezcon/crypto"
-	"log"
 	"time"
 )
 
 func (c *Consensus) RunEngine() {
-	ticker := time.NewTicker(3 * time.Second) // Ticker 3 seconds
+	ticker := time.NewTicker(c.Timing.Heartbeat)
 	defer ticker.Stop()
 	defer c.server.Stop()
 
@@ -37,7 +34,7 @@ func (c *Consensus) RunEngine() {
 				c.mutex.Lock()
 				defer c.mutex.Unlock()
 
-				// xử lý msg trong giai đoạn trạng thái engine chưa bắt đầu quá trình đồng thuận
+				// xử lý vị trí đầu tiên của một validator
 				c.handleProposal(msg)
 			}()
 
@@ -47,51 +44,39 @@ func (c *Consensus) RunEngine() {
 				c.mutex.Lock()
 				defer c.mutex.Unlock()
 
-				// xử lý msg trong giai đoạn đang đồng thuận
+				// xử lý vị trí cập nhật của một validator
 				c.handleVote(msg)
 			}()
-		case <-ticker.C: // Đây là trường hợp node tự đề xuất trong 3 giây
+
+		case msg := <-c.validationChan:
 
 			go func() {
 				c.mutex.Lock()
 				defer c.mutex.Unlock()
 
-				// Nếu trạng thái engine đang đồng thuận, thì không gửi consensus
-				if c.isConsensing {
-					return
-				}
+				// ghi nhận xác nhận ledger của một validator
+				c.handleValidation(msg)
+			}()
+
+		case msg := <-c.relayChan:
 
-				log.Println("Start send proposal transaction ...")
+			go c.handleRelay(msg)
 
-				// Lấy đề xuất các giao dịch
-				proposedTxs := c.getProposalTransaction()
+		case msg := <-c.ledgerRequestChan:
 
-				// Lưu vào danh sách các giao dịch đang đề xuất
-				c.saveProposalTransaction(proposedTxs)
+			go c.handleLedgerRequest(msg)
 
-				// marshal data
-				data, err := json.Marshal(proposedTxs)
-				if err != nil {
-					log.Println("can not marshal proposal txs", err)
-					return
-				}
+		case msg := <-c.ledgerDataChan:
 
-				// ký proposal transaction
-				signature, err := crypto.Sign(data, c.PrivKey)
-				if err != nil {
-					log.Println("can not sign data", err)
-					return
-				}
+			go c.handleLedgerData(msg)
 
-				// Chuyển tiếp các giao dịch đề xuất cho các node trong danh sách UNL
-				err = c.Broadcast(proposedTxs, signature)
-				if err != nil {
-					return
-				}
+		case <-ticker.C: // Mỗi nhịp heartbeat node đề xuất, bỏ phiếu lại hoặc bỏ vòng quá hạn
 
-				c.isConsensing = true
+			go func() {
+				c.mutex.Lock()
+				defer c.mutex.Unlock()
 
-				log.Println("Start consensus...")
+				c.heartbeat(time.Now())
 			}()
 
 		}
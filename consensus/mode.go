@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package consensus
+
+import (
+	"bytes"
+	"log"
+)
+
+// Mode cho biết node có tham gia đề xuất trong vòng đồng thuận hay không
+type Mode int
+
+const (
+	// ModeProposing: node gửi vị trí, bỏ phiếu và xác nhận ledger
+	ModeProposing Mode = iota
+
+	// ModeObserving: node lệch khỏi mạng nên chỉ theo dõi vòng đồng thuận,
+	// không gửi vị trí và không xác nhận ledger cho đến khi bắt kịp
+	ModeObserving
+)
+
+func (m Mode) String() string {
+	switch m {
+	case ModeProposing:
+		return "proposing"
+	case ModeObserving:
+		return "observing"
+	}
+	return "unknown"
+}
+
+// Mode trả về chế độ hiện tại của node
+func (c *Consensus) Mode() Mode {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.mode
+}
+
+// checkSync so ledger vừa được xác nhận đầy đủ với ledger của node. Node
+// rút khỏi đồng thuận khi mạng đã xác nhận ledger mà node chưa có hoặc một
+// ledger khác ledger node vừa đóng, và tham gia lại khi ledger của node trùng
+// với ledger mạng đã xác nhận. Caller phải giữ c.mutex
+func (c *Consensus) checkSync(seq uint64, hash []byte) {
+	switch {
+	case seq > c.ledgerSeq:
+		c.wrongLedger(seq, hash, "network validated ledger ahead of ours")
+	case seq == c.ledgerSeq:
+		// node chưa đóng xong ledger này, accept so sánh lại khi đóng xong
+	case seq+1 == c.ledgerSeq && !bytes.Equal(hash, c.closedHash):
+		c.wrongLedger(seq, hash, "network validated a different ledger")
+	case seq+1 == c.ledgerSeq:
+		c.rejoin()
+	}
+}
+
+// wrongLedger rút node khỏi đồng thuận và tải ledger seq có hash mà mạng đã
+// xác nhận. Caller phải giữ c.mutex
+func (c *Consensus) wrongLedger(seq uint64, hash []byte, reason string) {
+	c.bowOut(reason)
+	if c.onWrongLedger != nil {
+		c.onWrongLedger(seq, hash)
+	}
+}
+
+// bowOut chuyển node sang chế độ quan sát. Vị trí của node không còn được
+// tính trong vòng đang chạy. Caller phải giữ c.mutex
+func (c *Consensus) bowOut(reason string) {
+	if c.mode == ModeObserving {
+		return
+	}
+	c.mode = ModeObserving
+	c.round.proposing = false
+	log.Printf("Consensus ledger %d: bow out, %s", c.ledgerSeq, reason)
+}
+
+// rejoin đưa node trở lại đề xuất từ vòng tiếp theo. Node nằm ngoài UNL của
+// genesis không bao giờ đề xuất. Caller phải giữ c.mutex
+func (c *Consensus) rejoin() {
+	if c.mode == ModeProposing || c.outsider {
+		return
+	}
+	c.mode = ModeProposing
+	log.Printf("Consensus ledger %d: rejoin, ledger %d matches the network", c.ledgerSeq, c.ledgerSeq-1)
+}
@@ -18,65 +18,149 @@
 package consensus
 
 import (
-	"encoding/json"
+	"fmt"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
 	"github.com/ezcon-foundation/go-ezcon/core/transaction"
-	"github.com/ezcon-foundation/go-ezcon/crypto"
 	"github.com/ezcon-foundation/go-ezcon/node/tcp"
 	"log"
+	"time"
 )
 
-type ProposalMessage struct {
-	Txs     []*transaction.Transaction `json:"txs"`
-	NumVote int                        `json:"num_vote"`
-}
-
-// handleProposal sẽ tập trung vào việc xử lý các giao dịch đề xuất trong trạng thái nghỉ của validator
-func (c *Consensus) handleProposal(msg tcp.Message) {
+// MarshalBinary trả về mã hoá chuẩn của vị trí, là payload của message
+// proposal và position update
+func (p *position) MarshalBinary() ([]byte, error) {
+	txs := p.transactions()
+	items := make([][]byte, len(txs))
+	for i, tx := range txs {
+		blob, err := tx.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		items[i] = blob
+	}
 
-	hasProposal := false
-	var proposedTxs []*transaction.Transaction
+	e := codec.NewEncoder()
+	e.Uint64(codec.FieldSequence, p.seq)
+	e.Time(codec.FieldCloseTime, p.closeTime)
+	e.Array(codec.FieldTransactions, items)
+	return e.Bytes()
+}
 
-	// Lặp qua các node có trong UNL, xác định giao dịch được gửi đến
-	for _, node := range c.UNLPublicKey {
-		pubKey, err := crypto.PubKeyFromNode(node)
-		if err != nil {
-			log.Printf("Invalid pubkey: %v %v\n", err, node)
-			continue
+// decodePosition giải mã vị trí từ payload của message
+func decodePosition(data []byte) (*position, error) {
+	var (
+		seq       uint64
+		closeTime time.Time
+		txs       []transaction.Transaction
+	)
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldSequence:
+			seq = d.Uint64()
+		case codec.FieldCloseTime:
+			closeTime = d.Time()
+		case codec.FieldTransactions:
+			for _, item := range d.Array() {
+				tx, err := transaction.Decode(item)
+				if err != nil {
+					return nil, err
+				}
+				txs = append(txs, tx)
+			}
+		default:
+			d.Skip()
 		}
+	}
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
 
-		ok := crypto.Verify(msg.Txs, msg.Sig, pubKey)
-		// xác thực các giao dịch có phải đến từ các node đã biết hay không?
-		if ok {
+	// giao dịch không hợp lệ vẫn được bỏ phiếu, bước đóng ledger sẽ loại chúng
+	p, err := newPosition(txs, closeTime)
+	if err != nil {
+		return nil, err
+	}
+	p.seq = seq
+	return p, nil
+}
 
-			log.Printf("Receive msg from %v", node)
+// positionMessage mã hoá và ký vị trí của node: vị trí đầu tiên là một
+// proposal, các vị trí sau là position update
+func (c *Consensus) positionMessage(p *position) (tcp.Message, error) {
+	payload, err := p.MarshalBinary()
+	if err != nil {
+		return tcp.Message{}, err
+	}
+	t := tcp.MessagePositionUpdate
+	if p.seq == 0 {
+		t = tcp.MessageProposal
+	}
+	return c.newMessage(t, c.ledgerSeq, payload)
+}
 
-			// Cần phải phân biệt message nhận được thuộc loại message nào?
-			var proposalMessage ProposalMessage
-			if err := json.Unmarshal(msg.Txs, &proposalMessage); err != nil {
-				log.Printf("Invalid proposal: %v", err)
-				continue
-			}
+// readPosition kiểm tra message và giải mã vị trí trong đó. Message không đến
+// từ một node trong UNL hoặc không thuộc ledger đang đồng thuận bị bỏ qua.
+// Vị trí cho lần thử tiếp theo được giữ lại cho tới khi đủ validator sang lần
+// thử đó, node chỉ bỏ vòng khi ấy
+func (c *Consensus) readPosition(msg tcp.Message) (*position, bool) {
+	if !c.checkMessage(msg) {
+		return nil, false
+	}
+	if msg.LedgerSeq != c.ledgerSeq {
+		log.Printf("Position from %v for ledger %d while on ledger %d, dropping", msg.Sender, msg.LedgerSeq, c.ledgerSeq)
+		return nil, false
+	}
+	if msg.Round < c.round.attempt {
+		log.Printf("Position from %v for round %d while on round %d, dropping", msg.Sender, msg.Round, c.round.attempt)
+		return nil, false
+	}
 
-			// Kiểm tra các giao dịch có hợp lệ không, nếu hợp lệ thì đưa vào danh sách những giao dịch hợp lệ
-			// của node, lưu ý cần sắp xếp các giao dịch theo thứ tự sequence của account
-			for _, tx := range proposalMessage.Txs {
+	if msg.Round-c.round.attempt > 1 {
+		log.Printf("Position from %v for round %d while on round %d, dropping", msg.Sender, msg.Round, c.round.attempt)
+		return nil, false
+	}
 
-				// todo: kiểm tra tính hợp lệ của tx
-				proposedTxs = append(proposedTxs, tx)
-			}
+	p, err := decodePosition(msg.Payload)
+	if err != nil {
+		log.Printf("Invalid position from %v: %v", msg.Sender, err)
+		return nil, false
+	}
 
-			hasProposal = true
-			break
+	// một validator không đủ để bắt node bỏ vòng: node chỉ theo khi đủ tỷ lệ
+	// validator đã bỏ vòng trước nó
+	if msg.Round > c.round.attempt {
+		if !c.round.setAheadPosition(msg.Sender, p) {
+			return nil, false
 		}
+		delete(c.round.ahead, msg.Sender)
+		c.abandonRound(time.Now(), fmt.Sprintf("validators moved to round %d", msg.Round))
 	}
+	return p, true
+}
 
-	// Nếu giao dịch gửi đến không thuộc bất kỳ một node nào đã biết, thì không xử lý
-	if !hasProposal {
+// handleProposal xử lý vị trí đầu tiên của một validator trong vòng đồng thuận
+func (c *Consensus) handleProposal(msg tcp.Message) {
+	p, ok := c.readPosition(msg)
+
+	// Nếu message gửi đến không thuộc bất kỳ một node nào đã biết, thì không xử lý
+	if !ok {
 		return
 	}
+	log.Printf("Receive proposal from %v with %d transactions", msg.Sender, len(p.txs))
 
-	// khởi động trạng thái đồng thuận của node
-	c.isConsensing = true
+	c.receivePosition(msg.Sender, p)
+}
 
-	hasProposal = false
+// receivePosition ghi nhận vị trí của validator node. Khi ledger còn mở, node
+// bắt đầu establish với vị trí của chính nó, nếu không thì bỏ phiếu lại
+func (c *Consensus) receivePosition(node string, p *position) {
+	if !c.round.setPeerPosition(node, p) {
+		return
+	}
+	if c.round.phase == PhaseOpen {
+		c.startRound(time.Now())
+		return
+	}
+	c.updateRound(time.Now())
 }
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package consensus
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"github.com/ezcon-foundation/go-ezcon/core/transactor"
+	"github.com/ezcon-foundation/go-ezcon/node/tcp"
+	"log"
+)
+
+// Relay chuyển tiếp giao dịch vừa nhận qua RPC tới các node trong UNL để
+// giao dịch có mặt trong pool của các validator khác
+func (c *Consensus) Relay(tx transaction.Transaction) error {
+	payload, err := tx.Serialize()
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	msg, err := c.newMessage(tcp.MessageTxRelay, c.ledgerSeq, payload)
+	c.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	return c.Broadcast(msg)
+}
+
+// handleRelay đưa giao dịch được chuyển tiếp vào pool. Giao dịch không được
+// chuyển tiếp thêm lần nữa, vì node gửi đã gửi nó tới cả UNL
+func (c *Consensus) handleRelay(msg tcp.Message) {
+	c.mutex.Lock()
+	ok := c.checkMessage(msg)
+	networkID := c.networkID
+	c.mutex.Unlock()
+	if !ok {
+		return
+	}
+
+	tx, err := transaction.Decode(msg.Payload)
+	if err != nil {
+		log.Printf("Invalid relayed transaction from %v: %v", msg.Sender, err)
+		return
+	}
+	if err := transactor.Preflight(tx, networkID); err != nil {
+		log.Printf("Invalid relayed transaction from %v: %v", msg.Sender, err)
+		return
+	}
+	if _, err := c.Pool.Add(tx); err != nil {
+		log.Printf("Relayed transaction not added: %v", err)
+	}
+}
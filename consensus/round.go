@@ -0,0 +1,320 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package consensus
+
+import (
+	"bytes"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"math"
+	"sort"
+	"time"
+)
+
+// Phase là giai đoạn của một vòng đồng thuận
+type Phase int
+
+const (
+	// PhaseOpen: ledger đang mở, node gom giao dịch vào pool và chờ đề xuất
+	PhaseOpen Phase = iota
+
+	// PhaseEstablish: các validator trao đổi vị trí và bỏ phiếu cho từng giao dịch tranh chấp
+	PhaseEstablish
+
+	// PhaseAccepted: tập giao dịch đã được thống nhất và đang được đóng thành ledger
+	PhaseAccepted
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhaseOpen:
+		return "open"
+	case PhaseEstablish:
+		return "establish"
+	case PhaseAccepted:
+		return "accepted"
+	}
+	return "unknown"
+}
+
+// avalancheThresholds là tỷ lệ phiếu cần để giữ một giao dịch tranh chấp ở
+// từng bước establish. Sau bước cuối, ngưỡng là Consensus.Threshold
+var avalancheThresholds = []float64{0.5, 0.65, 0.7}
+
+// voteThreshold trả về ngưỡng giữ giao dịch sau khi establish được elapsed,
+// với mỗi bước dài avalancheStep, không bao giờ vượt quá final
+func voteThreshold(elapsed, avalancheStep time.Duration, final float64) float64 {
+	step := int(elapsed / avalancheStep)
+	if step < len(avalancheThresholds) && avalancheThresholds[step] < final {
+		return avalancheThresholds[step]
+	}
+	return final
+}
+
+// reached cho biết votes trên total có đạt tỷ lệ threshold hay không. So sánh
+// theo phần trăm nguyên để 0.8 * 5 luôn là 4
+func reached(votes, total int, threshold float64) bool {
+	percent := int(math.Round(threshold * 100))
+	return votes*100 >= percent*total
+}
+
+// position là tập giao dịch và thời điểm đóng ledger mà một validator đề xuất
+type position struct {
+	txs       map[block.Key]transaction.Transaction
+	closeTime time.Time
+
+	// seq là số thứ tự vị trí của validator trong vòng: 0 cho đề xuất đầu
+	// tiên, tăng mỗi lần vị trí thay đổi
+	seq uint64
+}
+
+// newPosition tạo vị trí từ danh sách giao dịch, close time làm tròn tới giây
+func newPosition(txs []transaction.Transaction, closeTime time.Time) (*position, error) {
+	p := &position{
+		txs:       make(map[block.Key]transaction.Transaction, len(txs)),
+		closeTime: closeTime.UTC().Truncate(time.Second),
+	}
+	for _, tx := range txs {
+		id, err := transaction.ID(tx)
+		if err != nil {
+			return nil, err
+		}
+		p.txs[id] = tx
+	}
+	return p, nil
+}
+
+// transactions trả về các giao dịch của vị trí, sắp theo ID để mọi node mã
+// hoá cùng một vị trí giống nhau
+func (p *position) transactions() []transaction.Transaction {
+	ids := make([]block.Key, 0, len(p.txs))
+	for id := range p.txs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+
+	txs := make([]transaction.Transaction, len(ids))
+	for i, id := range ids {
+		txs[i] = p.txs[id]
+	}
+	return txs
+}
+
+// equal cho biết hai vị trí có cùng tập giao dịch và cùng close time
+func (p *position) equal(o *position) bool {
+	if !p.closeTime.Equal(o.closeTime) || len(p.txs) != len(o.txs) {
+		return false
+	}
+	for id := range p.txs {
+		if _, ok := o.txs[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// round là trạng thái của vòng đồng thuận hiện tại
+type round struct {
+	phase Phase
+
+	// attempt là lần thử đồng thuận của ledger, tăng khi một vòng bị bỏ
+	attempt uint32
+
+	// threshold là tỷ lệ validator cần giữ cùng vị trí để kết thúc vòng,
+	// cũng là ngưỡng bỏ phiếu cuối cùng cho giao dịch tranh chấp
+	threshold float64
+
+	// validators là số validator của mạng, kể cả node này
+	validators int
+
+	// avalancheStep là thời gian giữa hai lần nâng ngưỡng bỏ phiếu
+	avalancheStep time.Duration
+
+	// openedAt là thời điểm ledger bắt đầu mở, startedAt là thời điểm bắt
+	// đầu establish
+	openedAt  time.Time
+	startedAt time.Time
+
+	// proposing cho biết vị trí của node được tính khi bỏ phiếu và khi đếm
+	// đồng thuận; node đang quan sát chỉ đi theo các validator khác
+	proposing bool
+
+	ours  *position
+	peers map[string]*position // vị trí mới nhất của từng validator khác
+
+	// ahead là vị trí của các validator đã sang lần thử attempt+1, được giữ
+	// cho tới khi node cũng sang lần thử đó
+	ahead map[string]*position
+}
+
+func newRound(threshold float64, validators int, avalancheStep time.Duration) *round {
+	return &round{
+		phase:         PhaseOpen,
+		threshold:     threshold,
+		validators:    validators,
+		avalancheStep: avalancheStep,
+		peers:         make(map[string]*position),
+		ahead:         make(map[string]*position),
+	}
+}
+
+// establish bắt đầu trao đổi vị trí, với vị trí ban đầu của node là ours
+func (r *round) establish(ours *position, now time.Time, proposing bool) {
+	r.phase = PhaseEstablish
+	r.startedAt = now
+	r.ours = ours
+	r.proposing = proposing
+}
+
+// setPeerPosition ghi nhận vị trí mới nhất của validator node. Vị trí nhận
+// được khi ledger còn mở được giữ lại cho lúc node bắt đầu establish, vị trí
+// cũ hơn vị trí đã có bị bỏ qua
+func (r *round) setPeerPosition(node string, p *position) bool {
+	if old, ok := r.peers[node]; ok && p.seq <= old.seq {
+		return false
+	}
+	r.peers[node] = p
+	return true
+}
+
+// setAheadPosition ghi nhận vị trí của validator node ở lần thử attempt+1 và
+// cho biết đã đủ tỷ lệ validator sang lần thử đó. Vị trí cũ hơn vị trí đã có
+// bị bỏ qua
+func (r *round) setAheadPosition(node string, p *position) bool {
+	if old, ok := r.ahead[node]; ok && p.seq <= old.seq {
+		return false
+	}
+	r.ahead[node] = p
+	return reached(len(r.ahead), r.validators, r.threshold)
+}
+
+// update bỏ phiếu lại cho mọi giao dịch tranh chấp theo ngưỡng hiện tại.
+// changed cho biết vị trí của node đã đổi và cần gửi lại cho các validator
+// khác; agreed cho biết đã đủ validator giữ cùng vị trí và vòng chuyển sang
+// PhaseAccepted
+func (r *round) update(now time.Time) (changed, agreed bool) {
+	if r.phase != PhaseEstablish {
+		return false, false
+	}
+	threshold := voteThreshold(now.Sub(r.startedAt), r.avalancheStep, r.threshold)
+	voters := len(r.peers) + r.self()
+	if voters == 0 {
+		return false, false
+	}
+
+	// giao dịch được giữ khi đủ tỷ lệ vị trí (kể cả vị trí của node) có nó
+	next := &position{
+		txs:       make(map[block.Key]transaction.Transaction),
+		closeTime: r.closeTimeVote(),
+		seq:       r.ours.seq + 1,
+	}
+	for _, candidate := range append([]*position{r.ours}, r.peerList()...) {
+		for id, tx := range candidate.txs {
+			if _, done := next.txs[id]; done {
+				continue
+			}
+			if reached(r.votes(id), voters, threshold) {
+				next.txs[id] = tx
+			}
+		}
+	}
+	if !next.equal(r.ours) {
+		r.ours = next
+		changed = true
+	}
+
+	// kết thúc vòng khi đủ tỷ lệ validator giữ cùng vị trí với node
+	agree := r.self()
+	for _, p := range r.peers {
+		if p.equal(r.ours) {
+			agree++
+		}
+	}
+	if reached(agree, r.validators, r.threshold) {
+		r.phase = PhaseAccepted
+		agreed = true
+	}
+	return changed, agreed
+}
+
+// self là số phiếu của node: 1 khi đang đề xuất, 0 khi chỉ quan sát
+func (r *round) self() int {
+	if r.proposing {
+		return 1
+	}
+	return 0
+}
+
+// votes đếm số vị trí (kể cả vị trí của node khi đang đề xuất) có giao dịch id
+func (r *round) votes(id block.Key) int {
+	n := 0
+	if _, ok := r.ours.txs[id]; ok {
+		n += r.self()
+	}
+	for _, p := range r.peers {
+		if _, ok := p.txs[id]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// closeTimeVote trả về close time được nhiều vị trí chọn nhất, hoà thì lấy
+// thời điểm muộn hơn
+func (r *round) closeTimeVote() time.Time {
+	counts := make(map[int64]int)
+	counts[r.ours.closeTime.Unix()] += r.self()
+	for _, p := range r.peers {
+		counts[p.closeTime.Unix()]++
+	}
+
+	best := r.ours.closeTime.Unix()
+	for t, n := range counts {
+		if n > counts[best] || (n == counts[best] && t > best) {
+			best = t
+		}
+	}
+	return time.Unix(best, 0).UTC()
+}
+
+// peerList trả về vị trí của các validator khác
+func (r *round) peerList() []*position {
+	list := make([]*position, 0, len(r.peers))
+	for _, p := range r.peers {
+		list = append(list, p)
+	}
+	return list
+}
+
+// reset đưa vòng về PhaseOpen cho ledger tiếp theo, mở từ now
+func (r *round) reset(now time.Time) {
+	r.restart(0, now)
+}
+
+// restart bỏ vòng hiện tại và mở lại ledger cho lần thử attempt
+func (r *round) restart(attempt uint32, now time.Time) {
+	r.phase = PhaseOpen
+	r.attempt = attempt
+	r.openedAt = now
+	r.startedAt = time.Time{}
+	r.ours = nil
+	r.peers = make(map[string]*position)
+	r.ahead = make(map[string]*position)
+}
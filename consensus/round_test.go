@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package consensus
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+const avalancheStep = 3 * time.Second
+
+func testTx(seq uint64) transaction.Transaction {
+	return &transaction.Payment{
+		BaseTransaction: transaction.BaseTransaction{TxType: "Payment", Account: "alice", Sequence: seq, Fee: 10},
+		Destination:     "bob",
+	}
+}
+
+func testPosition(t *testing.T, closeTime time.Time, seqs ...uint64) *position {
+	t.Helper()
+	txs := make([]transaction.Transaction, len(seqs))
+	for i, seq := range seqs {
+		txs[i] = testTx(seq)
+	}
+	p, err := newPosition(txs, closeTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestVoteThreshold(t *testing.T) {
+	for _, tc := range []struct {
+		elapsed time.Duration
+		want    float64
+	}{
+		{0, 0.5},
+		{avalancheStep, 0.65},
+		{2 * avalancheStep, 0.7},
+		{3 * avalancheStep, 0.8},
+		{time.Hour, 0.8},
+	} {
+		if got := voteThreshold(tc.elapsed, avalancheStep, 0.8); got != tc.want {
+			t.Errorf("threshold after %v: got %v, want %v", tc.elapsed, got, tc.want)
+		}
+	}
+	if got := voteThreshold(0, avalancheStep, 0.4); got != 0.4 {
+		t.Errorf("threshold above final: got %v", got)
+	}
+	if !reached(4, 5, 0.8) || reached(3, 5, 0.8) {
+		t.Error("4 of 5 must reach 0.8 and 3 of 5 must not")
+	}
+}
+
+func TestRoundAgreement(t *testing.T) {
+	r := newRound(0.8, 3, avalancheStep)
+	r.establish(testPosition(t, testNow, 1, 2), testNow, true)
+
+	// mỗi giao dịch chỉ có ở một trong ba vị trí thì bị bỏ
+	r.setPeerPosition("b", testPosition(t, testNow.Add(time.Second), 1, 3))
+	r.setPeerPosition("c", testPosition(t, testNow.Add(time.Second), 1))
+	changed, agreed := r.update(testNow)
+	if !changed || agreed {
+		t.Fatalf("got changed %v agreed %v", changed, agreed)
+	}
+	if want := testPosition(t, testNow.Add(time.Second), 1); !r.ours.equal(want) {
+		t.Fatalf("got position with %d transactions at %v", len(r.ours.txs), r.ours.closeTime)
+	}
+
+	// chỉ c giữ cùng vị trí: 2 trên 3 validator chưa đạt 0.8
+	if _, agreed := r.update(testNow); agreed {
+		t.Fatal("agreed with 2 of 3 validators")
+	}
+	update := testPosition(t, testNow.Add(time.Second), 1)
+	update.seq = 1
+	r.setPeerPosition("b", update)
+	if _, agreed := r.update(testNow); !agreed || r.phase != PhaseAccepted {
+		t.Fatalf("not accepted in phase %v", r.phase)
+	}
+
+	r.reset(testNow)
+	if r.phase != PhaseOpen || len(r.peers) != 0 {
+		t.Fatal("reset kept the round")
+	}
+}
+
+func TestRoundRisingThreshold(t *testing.T) {
+	r := newRound(0.8, 4, avalancheStep)
+	r.establish(testPosition(t, testNow, 1), testNow, true)
+	r.setPeerPosition("b", testPosition(t, testNow, 1, 2))
+	r.setPeerPosition("c", testPosition(t, testNow, 1, 2))
+	r.setPeerPosition("d", testPosition(t, testNow, 1))
+
+	// 2 trên 4 vị trí đạt ngưỡng 0.5 ban đầu nên giao dịch 2 được giữ
+	if changed, _ := r.update(testNow); !changed || len(r.ours.txs) != 2 {
+		t.Fatalf("got %d transactions", len(r.ours.txs))
+	}
+
+	// 3 trên 4 không đạt ngưỡng cuối 0.8, giao dịch 2 bị bỏ
+	if changed, _ := r.update(testNow.Add(3 * avalancheStep)); !changed || len(r.ours.txs) != 1 {
+		t.Fatalf("got %d transactions", len(r.ours.txs))
+	}
+}
+
+func TestPositionEncoding(t *testing.T) {
+	p := testPosition(t, testNow, 1, 2)
+	p.seq = 3
+	data, err := p.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := decodePosition(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.equal(p) || got.seq != 3 {
+		t.Fatalf("got position with %d transactions, seq %d", len(got.txs), got.seq)
+	}
+}
+
+func TestStalePosition(t *testing.T) {
+	r := newRound(0.8, 2, avalancheStep)
+	p := testPosition(t, testNow, 1)
+	p.seq = 2
+	if !r.setPeerPosition("b", p) {
+		t.Fatal("first position refused")
+	}
+	if r.setPeerPosition("b", testPosition(t, testNow, 2)) {
+		t.Fatal("older position accepted")
+	}
+}
+
+func TestObserverDoesNotVote(t *testing.T) {
+	r := newRound(0.8, 3, avalancheStep)
+	r.establish(testPosition(t, testNow, 1, 2), testNow, false)
+	r.setPeerPosition("b", testPosition(t, testNow, 1))
+	r.setPeerPosition("c", testPosition(t, testNow, 1))
+
+	// node quan sát đi theo vị trí của b và c nhưng không được tính để kết thúc vòng
+	changed, agreed := r.update(testNow)
+	if !changed || len(r.ours.txs) != 1 {
+		t.Fatalf("got %d transactions", len(r.ours.txs))
+	}
+	if agreed {
+		t.Fatal("2 of 3 validators must not reach 0.8")
+	}
+}
+
+func TestRoundRestart(t *testing.T) {
+	r := newRound(0.8, 2, avalancheStep)
+	r.establish(testPosition(t, testNow, 1), testNow, true)
+	r.setPeerPosition("b", testPosition(t, testNow, 2))
+
+	later := testNow.Add(time.Minute)
+	r.restart(r.attempt+1, later)
+	if r.phase != PhaseOpen || r.attempt != 1 || len(r.peers) != 0 || !r.openedAt.Equal(later) {
+		t.Fatalf("restart left phase %v attempt %d with %d peers", r.phase, r.attempt, len(r.peers))
+	}
+
+	r.reset(later)
+	if r.attempt != 0 {
+		t.Fatalf("reset kept attempt %d", r.attempt)
+	}
+}
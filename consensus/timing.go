@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package consensus
+
+import "time"
+
+// Timing là các mốc thời gian của vòng đồng thuận
+type Timing struct {
+	// Heartbeat là nhịp engine kiểm tra trạng thái vòng
+	Heartbeat time.Duration
+
+	// LedgerInterval là thời gian ledger mở trước khi node đề xuất
+	LedgerInterval time.Duration
+
+	// AvalancheStep là thời gian establish giữa hai lần nâng ngưỡng bỏ phiếu
+	AvalancheStep time.Duration
+
+	// MaxEstablish là thời gian establish tối đa, quá mốc này mà chưa đạt
+	// đồng thuận thì node bỏ vòng và bắt đầu vòng tiếp theo
+	MaxEstablish time.Duration
+}
+
+// DefaultTiming là các mốc thời gian mặc định
+var DefaultTiming = Timing{
+	Heartbeat:      time.Second,
+	LedgerInterval: 3 * time.Second,
+	AvalancheStep:  3 * time.Second,
+	MaxEstablish:   30 * time.Second,
+}
+
+// withDefaults thay các mốc chưa đặt bằng mốc mặc định
+func (t Timing) withDefaults() Timing {
+	if t.Heartbeat <= 0 {
+		t.Heartbeat = DefaultTiming.Heartbeat
+	}
+	if t.LedgerInterval <= 0 {
+		t.LedgerInterval = DefaultTiming.LedgerInterval
+	}
+	if t.AvalancheStep <= 0 {
+		t.AvalancheStep = DefaultTiming.AvalancheStep
+	}
+	if t.MaxEstablish <= 0 {
+		t.MaxEstablish = DefaultTiming.MaxEstablish
+	}
+	return t
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+	"github.com/ezcon-foundation/go-ezcon/node/tcp"
+	"log"
+)
+
+// validationWindow là số ledger tối đa sau ledger đang đồng thuận mà node còn
+// ghi nhận xác nhận. Xác nhận xa hơn bị bỏ qua để một validator không thể làm
+// byLedger lớn mãi
+const validationWindow = 256
+
+// ValidatedFunc nhận ledger vừa được đủ quorum validator xác nhận
+type ValidatedFunc func(seq uint64, hash []byte)
+
+// validations gom xác nhận của các validator cho từng ledger và cho biết
+// ledger nào được xác nhận đầy đủ
+type validations struct {
+	threshold  float64
+	validators int
+
+	// byLedger là hash mà mỗi validator xác nhận cho từng ledger seq
+	byLedger map[uint64]map[string][]byte
+
+	// validated và validatedHash là seq và hash của ledger được xác nhận đầy
+	// đủ gần nhất
+	validated     uint64
+	validatedHash []byte
+}
+
+func newValidations(threshold float64, validators int) *validations {
+	return &validations{
+		threshold:  threshold,
+		validators: validators,
+		byLedger:   make(map[uint64]map[string][]byte),
+	}
+}
+
+// add ghi nhận xác nhận của node cho ledger seq có hash. Khi hash đạt quorum
+// lần đầu, add trả về true và ledger seq trở thành ledger được xác nhận đầy
+// đủ. Xác nhận cho ledger không mới hơn ledger đó bị bỏ qua
+func (v *validations) add(node string, seq uint64, hash []byte) bool {
+	if seq <= v.validated {
+		return false
+	}
+	if v.byLedger[seq] == nil {
+		v.byLedger[seq] = make(map[string][]byte)
+	}
+	v.byLedger[seq][node] = hash
+
+	count := 0
+	for _, h := range v.byLedger[seq] {
+		if bytes.Equal(h, hash) {
+			count++
+		}
+	}
+	if !reached(count, v.validators, v.threshold) {
+		return false
+	}
+
+	// xác nhận của các ledger cũ không còn cần nữa
+	v.validated = seq
+	v.validatedHash = hash
+	for s := range v.byLedger {
+		if s <= seq {
+			delete(v.byLedger, s)
+		}
+	}
+	return true
+}
+
+// SetValidatedHandler đăng ký hàm nhận ledger được xác nhận đầy đủ
+func (c *Consensus) SetValidatedHandler(fn ValidatedFunc) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onValidated = fn
+}
+
+// validate ký và gửi xác nhận của node cho ledger vừa đóng. Caller phải giữ c.mutex
+func (c *Consensus) validate(closed *block.Block) {
+	payload, err := validationPayload(closed.Header.Hash)
+	if err != nil {
+		log.Println("can not encode validation", err)
+		return
+	}
+	msg, err := c.newMessage(tcp.MessageValidation, closed.Header.Index, payload)
+	if err != nil {
+		log.Println("can not sign validation", err)
+		return
+	}
+	if err := c.Broadcast(msg); err != nil {
+		log.Printf("Broadcast validation failed: %v", err)
+	}
+
+	c.addValidation(c.publicKey, closed.Header.Index, closed.Header.Hash)
+}
+
+// handleValidation ghi nhận xác nhận của một validator khác
+func (c *Consensus) handleValidation(msg tcp.Message) {
+	if !c.checkMessage(msg) {
+		return
+	}
+	hash, err := readValidationPayload(msg.Payload)
+	if err != nil {
+		log.Printf("Invalid validation from %v: %v", msg.Sender, err)
+		return
+	}
+	log.Printf("Receive validation from %v for ledger %d hash %x", msg.Sender, msg.LedgerSeq, hash)
+
+	c.addValidation(msg.Sender, msg.LedgerSeq, hash)
+}
+
+// addValidation ghi nhận xác nhận và báo ledger vừa được xác nhận đầy đủ.
+// Xác nhận cho ledger quá xa phía trước bị bỏ qua. Caller phải giữ c.mutex
+func (c *Consensus) addValidation(node string, seq uint64, hash []byte) {
+	if seq > c.ledgerSeq+validationWindow {
+		log.Printf("Validation from %v for ledger %d while on ledger %d, dropping", node, seq, c.ledgerSeq)
+		return
+	}
+	if !c.validations.add(node, seq, hash) {
+		return
+	}
+	log.Printf("Ledger %d hash %x fully validated", seq, hash)
+	if c.onValidated != nil {
+		c.onValidated(seq, hash)
+	}
+	c.checkSync(seq, hash)
+}
+
+// validationPayload mã hoá hash của ledger được xác nhận
+func validationPayload(hash []byte) ([]byte, error) {
+	e := codec.NewEncoder()
+	e.Blob(codec.FieldHash, hash)
+	return e.Bytes()
+}
+
+// readValidationPayload giải mã hash của ledger được xác nhận
+func readValidationPayload(data []byte) ([]byte, error) {
+	var hash []byte
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldHash:
+			hash = d.Blob()
+		default:
+			d.Skip()
+		}
+	}
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
+	if len(hash) == 0 {
+		return nil, errors.New("validation without ledger hash")
+	}
+	return hash, nil
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package consensus
+
+import "testing"
+
+func TestValidationQuorum(t *testing.T) {
+	v := newValidations(0.8, 5)
+	hash, other := []byte{1}, []byte{2}
+
+	for _, node := range []string{"a", "b", "c"} {
+		if v.add(node, 7, hash) {
+			t.Fatalf("validated with %s", node)
+		}
+	}
+	if v.add("d", 7, other) {
+		t.Fatal("validated with a different hash")
+	}
+
+	// d đổi xác nhận sang cùng hash: 4 trên 5 validator đạt 0.8
+	if !v.add("d", 7, hash) || v.validated != 7 {
+		t.Fatal("ledger 7 not validated")
+	}
+	if v.add("e", 7, hash) || v.add("a", 6, hash) {
+		t.Fatal("validated an old ledger again")
+	}
+	if len(v.byLedger) != 0 {
+		t.Fatal("old validations kept")
+	}
+}
+
+func TestValidationWindow(t *testing.T) {
+	c := testConsensus(t, "a", "b", "c", "d")
+	_, a := testValidator(t, "a")
+
+	// xác nhận quá xa phía trước không được ghi nhận
+	for seq := c.ledgerSeq + validationWindow + 1; seq < c.ledgerSeq+validationWindow+10; seq++ {
+		c.addValidation(a, seq, []byte{1})
+	}
+	if len(c.validations.byLedger) != 0 {
+		t.Fatalf("kept validations for %d ledgers", len(c.validations.byLedger))
+	}
+
+	c.addValidation(a, c.ledgerSeq+validationWindow, []byte{1})
+	if len(c.validations.byLedger) != 1 {
+		t.Fatal("validation inside the window dropped")
+	}
+}
@@ -19,8 +19,16 @@ package consensus
 
 import (
 	"github.com/ezcon-foundation/go-ezcon/node/tcp"
+	"log"
 )
 
+// handleVote xử lý vị trí cập nhật của một validator trong lúc establish
 func (c *Consensus) handleVote(msg tcp.Message) {
+	p, ok := c.readPosition(msg)
+	if !ok {
+		return
+	}
+	log.Printf("Receive position update from %v with %d transactions", msg.Sender, len(p.txs))
 
+	c.receivePosition(msg.Sender, p)
 }
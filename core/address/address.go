@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package address encodes account addresses and node public keys as
+// base58check strings. An encoded value is a version byte, the payload and a
+// four byte checksum (the first bytes of a double SHA-256), written in base58.
+// The version byte keeps the kinds of values apart, and the checksum catches
+// mistyped characters.
+package address
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+)
+
+// Version bytes. Account addresses always start with 'e' and ed25519 node
+// public keys with 'N'.
+const (
+	VersionAccount       byte = 0x5c
+	VersionNodePublicKey byte = 0x8f
+)
+
+const checksumSize = 4
+
+var (
+	ErrEncoding = errors.New("address: invalid base58 encoding")
+	ErrChecksum = errors.New("address: checksum mismatch")
+	ErrVersion  = errors.New("address: unexpected version")
+	ErrLength   = errors.New("address: unexpected payload length")
+)
+
+func checksum(data []byte) []byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return second[:checksumSize]
+}
+
+// Encode returns the base58check encoding of payload under version
+func Encode(version byte, payload []byte) string {
+	data := append([]byte{version}, payload...)
+	return encodeBase58(append(data, checksum(data)...))
+}
+
+// Decode checks the checksum of a base58check string and returns its
+// version and payload
+func Decode(s string) (byte, []byte, error) {
+	data, ok := decodeBase58(s)
+	if !ok {
+		return 0, nil, ErrEncoding
+	}
+	if len(data) < 1+checksumSize {
+		return 0, nil, ErrLength
+	}
+	body, sum := data[:len(data)-checksumSize], data[len(data)-checksumSize:]
+	if !bytes.Equal(checksum(body), sum) {
+		return 0, nil, ErrChecksum
+	}
+	return body[0], body[1:], nil
+}
+
+// decodeVersion decodes s and checks that it holds a value of the given version
+func decodeVersion(s string, version byte) ([]byte, error) {
+	v, payload, err := Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if v != version {
+		return nil, fmt.Errorf("%w: %#x", ErrVersion, v)
+	}
+	return payload, nil
+}
+
+// FromPublicKey returns the address of the account controlled by an encoded
+// public key
+func FromPublicKey(pubKey []byte) string {
+	return Encode(VersionAccount, keys.AccountHash(pubKey))
+}
+
+// ParseAccount decodes an account address and returns its account hash
+func ParseAccount(addr string) ([]byte, error) {
+	hash, err := decodeVersion(addr, VersionAccount)
+	if err != nil {
+		return nil, err
+	}
+	if len(hash) != keys.AccountHashSize {
+		return nil, ErrLength
+	}
+	return hash, nil
+}
+
+// ValidateAccount reports why addr is not a valid account address, or nil
+func ValidateAccount(addr string) error {
+	_, err := ParseAccount(addr)
+	return err
+}
+
+// EncodeNodePublicKey returns the text form of an encoded node public key
+func EncodeNodePublicKey(pubKey []byte) string {
+	return Encode(VersionNodePublicKey, pubKey)
+}
+
+// ParseNodePublicKey decodes the text form of a node public key, checking
+// that it is a key of a known scheme
+func ParseNodePublicKey(s string) ([]byte, error) {
+	pubKey, err := decodeVersion(s, VersionNodePublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := keys.ParsePublicKey(pubKey); err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package address
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+)
+
+func testPublicKey(t *testing.T) []byte {
+	t.Helper()
+	pub, _, err := keys.FromSeed(keys.DefaultScheme, bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey, err := keys.MarshalPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pubKey
+}
+
+func TestBase58(t *testing.T) {
+	for _, data := range [][]byte{{0}, {0, 0, 1}, {0xff, 0xfe}, bytes.Repeat([]byte{0x5a}, 40)} {
+		decoded, ok := decodeBase58(encodeBase58(data))
+		if !ok || !bytes.Equal(decoded, data) {
+			t.Fatalf("round trip of %x gave %x", data, decoded)
+		}
+	}
+	if encodeBase58([]byte{0, 0, 57}) != "11z" {
+		t.Fatal("unexpected encoding")
+	}
+	for _, s := range []string{"", "0", "O", "I", "l", "abc+"} {
+		if _, ok := decodeBase58(s); ok {
+			t.Fatalf("%q accepted", s)
+		}
+	}
+}
+
+func TestAccountAddress(t *testing.T) {
+	pubKey := testPublicKey(t)
+	addr := FromPublicKey(pubKey)
+	if !strings.HasPrefix(addr, "e") {
+		t.Fatalf("account address %s does not start with 'e'", addr)
+	}
+
+	hash, err := ParseAccount(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(hash, keys.AccountHash(pubKey)) {
+		t.Fatal("address does not carry the account hash")
+	}
+
+	// changing any single character is caught by the checksum
+	for i := 1; i < len(addr); i++ {
+		c := byte('2')
+		if addr[i] == c {
+			c = '3'
+		}
+		typo := addr[:i] + string(c) + addr[i+1:]
+		if err := ValidateAccount(typo); err == nil {
+			t.Fatalf("typo %s accepted", typo)
+		}
+	}
+
+	if err := ValidateAccount("alice"); err == nil {
+		t.Fatal("arbitrary string accepted as an address")
+	}
+}
+
+func TestNodePublicKey(t *testing.T) {
+	pubKey := testPublicKey(t)
+	text := EncodeNodePublicKey(pubKey)
+	if !strings.HasPrefix(text, "N") {
+		t.Fatalf("node public key %s does not start with 'N'", text)
+	}
+
+	decoded, err := ParseNodePublicKey(text)
+	if err != nil || !bytes.Equal(decoded, pubKey) {
+		t.Fatalf("got %x %v", decoded, err)
+	}
+
+	// account addresses and node keys cannot be mistaken for each other
+	if _, err := ParseNodePublicKey(FromPublicKey(pubKey)); !errors.Is(err, ErrVersion) {
+		t.Fatalf("account address parsed as node key: %v", err)
+	}
+	if err := ValidateAccount(text); !errors.Is(err, ErrVersion) {
+		t.Fatalf("node key parsed as account address: %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package address
+
+import "math/big"
+
+const alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	radix = big.NewInt(58)
+
+	// alphabetIndex maps a character to its digit, or -1
+	alphabetIndex [256]int
+)
+
+func init() {
+	for i := range alphabetIndex {
+		alphabetIndex[i] = -1
+	}
+	for i := 0; i < len(alphabet); i++ {
+		alphabetIndex[alphabet[i]] = i
+	}
+}
+
+// encodeBase58 writes data in base58, each leading zero byte as a '1'
+func encodeBase58(data []byte) string {
+	n := new(big.Int).SetBytes(data)
+	mod := new(big.Int)
+
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, alphabet[mod.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		out = append(out, alphabet[0])
+	}
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+// decodeBase58 reverses encodeBase58 and reports whether s is valid base58
+func decodeBase58(s string) ([]byte, bool) {
+	if s == "" {
+		return nil, false
+	}
+
+	n := new(big.Int)
+	zeros := 0
+	leading := true
+	for i := 0; i < len(s); i++ {
+		digit := alphabetIndex[s[i]]
+		if digit < 0 {
+			return nil, false
+		}
+		if leading && digit == 0 {
+			zeros++
+			continue
+		}
+		leading = false
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(digit)))
+	}
+
+	return append(make([]byte, zeros), n.Bytes()...), true
+}
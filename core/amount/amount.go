@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package amount defines the currency amounts moved by transactions and held
+// by ledger objects
+package amount
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// NativeCurrency is the currency code of EZC, the native asset counted in drops
+const NativeCurrency = "EZC"
+
+// Amount represents a currency amount. A native amount has no issuer and an
+// empty or EZC currency, its value is a whole number of drops. Any other
+// amount is an issued currency and names its issuer.
+type Amount struct {
+	Value    Value  `json:"value"`
+	Currency string `json:"currency"`
+	Issuer   string `json:"issuer"`
+}
+
+// Drops returns a native amount of drops
+func Drops(drops uint64) Amount {
+	return Amount{Value: FromUint64(drops)}
+}
+
+// IsNative reports whether the amount is in EZC
+func (a Amount) IsNative() bool {
+	return a.Issuer == "" && (a.Currency == "" || a.Currency == NativeCurrency)
+}
+
+// Issue returns the asset the amount is counted in
+func (a Amount) Issue() Issue {
+	if a.IsNative() {
+		return Issue{Currency: NativeCurrency}
+	}
+	return Issue{Currency: a.Currency, Issuer: a.Issuer}
+}
+
+// MarshalBinary returns the canonical encoding of the amount
+func (a Amount) MarshalBinary() ([]byte, error) {
+	value, err := a.Value.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	e := codec.NewEncoder()
+	e.Blob(codec.FieldAmountValue, value)
+	e.String(codec.FieldCurrency, a.Currency)
+	e.String(codec.FieldIssuer, a.Issuer)
+	return e.Bytes()
+}
+
+// UnmarshalBinary decodes the amount from its canonical encoding
+func (a *Amount) UnmarshalBinary(data []byte) error {
+	*a = Amount{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldAmountValue:
+			if err := a.Value.UnmarshalBinary(d.Blob()); err != nil {
+				return err
+			}
+		case codec.FieldCurrency:
+			a.Currency = d.String()
+		case codec.FieldIssuer:
+			a.Issuer = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+// Issue identifies an asset: EZC, or a currency together with its issuer
+type Issue struct {
+	Currency string `json:"currency"`
+	Issuer   string `json:"issuer"`
+}
+
+// IsNative reports whether the issue is EZC
+func (i Issue) IsNative() bool {
+	return i.Issuer == "" && (i.Currency == "" || i.Currency == NativeCurrency)
+}
+
+// Amount returns value counted in the issue
+func (i Issue) Amount(value Value) Amount {
+	return Amount{Value: value, Currency: i.Currency, Issuer: i.Issuer}
+}
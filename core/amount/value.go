@@ -0,0 +1,459 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package amount
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Value is a signed decimal number, mantissa × 10^exponent. Non zero values
+// keep a mantissa of exactly Precision digits, so each number has a single
+// representation and therefore a single string and binary encoding. The
+// zero Value is the number zero.
+type Value struct {
+	mantissa uint64 // 0, or between MinMantissa and MaxMantissa
+	exponent int    // between MinExponent and MaxExponent, 0 for zero
+	negative bool
+}
+
+// Range of a Value. Precision digits hold any amount of drops up to
+// MaxMantissa exactly.
+const (
+	Precision          = 19
+	MinMantissa uint64 = 1_000_000_000_000_000_000
+	MaxMantissa uint64 = 9_999_999_999_999_999_999
+	MinExponent        = -96
+	MaxExponent        = 80
+)
+
+// MaxValue is the largest Value
+var MaxValue = Value{mantissa: MaxMantissa, exponent: MaxExponent}
+
+// valueSize is the size of the binary encoding of a non zero value: a sign
+// byte, the exponent biased by -MinExponent and the big endian mantissa
+const valueSize = 10
+
+// maxParseLength bounds the spelling Parse reads
+const maxParseLength = 128
+
+var (
+	ErrOverflow     = errors.New("amount: value out of range")
+	ErrDivideByZero = errors.New("amount: division by zero")
+	ErrSyntax       = errors.New("amount: invalid value")
+)
+
+// Rounding tells how a result with more than Precision digits is rounded
+type Rounding uint8
+
+const (
+	// RoundHalfEven rounds to the nearest value, and ties to an even mantissa
+	RoundHalfEven Rounding = iota
+	// RoundDown rounds toward zero
+	RoundDown
+	// RoundUp rounds away from zero
+	RoundUp
+)
+
+var (
+	bigOne = big.NewInt(1)
+	bigTen = big.NewInt(10)
+)
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// New returns mantissa × 10^exponent, rounded half to even
+func New(mantissa int64, exponent int) (Value, error) {
+	mag := new(big.Int).Abs(big.NewInt(mantissa))
+	return round(mantissa < 0, mag, exponent, false, RoundHalfEven)
+}
+
+// FromUint64 returns v. Values beyond Precision digits are rounded toward
+// zero.
+func FromUint64(v uint64) Value {
+	// a uint64 has at most one digit more than Precision, well within range
+	r, _ := round(false, new(big.Int).SetUint64(v), 0, false, RoundDown)
+	return r
+}
+
+// round returns ±mag × 10^exp with Precision digits. Sticky tells that mag
+// was itself truncated, so that a tie is in fact above the halfway point.
+// Values below the smallest non zero value become zero.
+func round(negative bool, mag *big.Int, exp int, sticky bool, mode Rounding) (Value, error) {
+	if mag.Sign() == 0 {
+		return Value{}, nil
+	}
+
+	q := new(big.Int).Set(mag)
+	if n := len(q.Text(10)); n > Precision {
+		d := pow10(n - Precision)
+		rem := new(big.Int)
+		q.QuoRem(q, d, rem)
+		exp += n - Precision
+		if roundsUp(q, rem, d, sticky, mode) {
+			q.Add(q, bigOne)
+			if q.Uint64() > MaxMantissa {
+				q.Quo(q, bigTen)
+				exp++
+			}
+		}
+	} else if n < Precision {
+		q.Mul(q, pow10(Precision-n))
+		exp -= Precision - n
+	}
+
+	if exp > MaxExponent {
+		return Value{}, ErrOverflow
+	}
+	if exp < MinExponent {
+		return Value{}, nil
+	}
+	return Value{mantissa: q.Uint64(), exponent: exp, negative: negative}, nil
+}
+
+// roundsUp reports whether q, the quotient of a division by d that left
+// rem, has to be incremented under mode
+func roundsUp(q, rem, d *big.Int, sticky bool, mode Rounding) bool {
+	switch mode {
+	case RoundDown:
+		return false
+	case RoundUp:
+		return rem.Sign() != 0 || sticky
+	default:
+		c := new(big.Int).Lsh(rem, 1).Cmp(d)
+		return c > 0 || c == 0 && (sticky || q.Bit(0) == 1)
+	}
+}
+
+// big returns the signed mantissa of v
+func (v Value) big() *big.Int {
+	b := new(big.Int).SetUint64(v.mantissa)
+	if v.negative {
+		b.Neg(b)
+	}
+	return b
+}
+
+// IsZero reports whether v is zero
+func (v Value) IsZero() bool {
+	return v.mantissa == 0
+}
+
+// Sign returns -1, 0 or 1 as v is negative, zero or positive
+func (v Value) Sign() int {
+	switch {
+	case v.mantissa == 0:
+		return 0
+	case v.negative:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Neg returns -v
+func (v Value) Neg() Value {
+	if v.mantissa != 0 {
+		v.negative = !v.negative
+	}
+	return v
+}
+
+// Abs returns |v|
+func (v Value) Abs() Value {
+	v.negative = false
+	return v
+}
+
+// Cmp returns -1, 0 or 1 as v is less than, equal to or greater than w
+func (v Value) Cmp(w Value) int {
+	if s, t := v.Sign(), w.Sign(); s != t || s == 0 {
+		return cmpInt(s, t)
+	}
+	c := cmpInt(v.exponent, w.exponent)
+	if c == 0 {
+		c = cmpInt(v.mantissa, w.mantissa)
+	}
+	if v.negative {
+		return -c
+	}
+	return c
+}
+
+func cmpInt[T int | uint64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Min returns the smallest of its arguments
+func Min(v Value, others ...Value) Value {
+	for _, w := range others {
+		if w.Cmp(v) < 0 {
+			v = w
+		}
+	}
+	return v
+}
+
+// CompareProducts compares a × b with c × d, computed exactly, returning
+// -1, 0 or 1
+func CompareProducts(a, b, c, d Value) int {
+	x, y := a.big(), c.big()
+	x.Mul(x, b.big())
+	y.Mul(y, d.big())
+	ex, ey := a.exponent+b.exponent, c.exponent+d.exponent
+	if ex > ey {
+		x.Mul(x, pow10(ex-ey))
+	} else {
+		y.Mul(y, pow10(ey-ex))
+	}
+	return x.Cmp(y)
+}
+
+// Add returns v + w, rounded half to even
+func (v Value) Add(w Value) (Value, error) {
+	if v.IsZero() {
+		return w, nil
+	}
+	if w.IsZero() {
+		return v, nil
+	}
+	exp := min(v.exponent, w.exponent)
+	a := v.big()
+	a.Mul(a, pow10(v.exponent-exp))
+	b := w.big()
+	b.Mul(b, pow10(w.exponent-exp))
+	a.Add(a, b)
+	return round(a.Sign() < 0, a.Abs(a), exp, false, RoundHalfEven)
+}
+
+// Sub returns v - w, rounded half to even
+func (v Value) Sub(w Value) (Value, error) {
+	return v.Add(w.Neg())
+}
+
+// Mul returns v × w, rounded under mode
+func (v Value) Mul(w Value, mode Rounding) (Value, error) {
+	mag := new(big.Int).SetUint64(v.mantissa)
+	mag.Mul(mag, new(big.Int).SetUint64(w.mantissa))
+	return round(v.negative != w.negative, mag, v.exponent+w.exponent, false, mode)
+}
+
+// Div returns v / w, rounded under mode
+func (v Value) Div(w Value, mode Rounding) (Value, error) {
+	return v.MulDiv(FromUint64(1), w, mode)
+}
+
+// MulDiv returns v × m / d computed exactly, then rounded under mode
+func (v Value) MulDiv(m, d Value, mode Rounding) (Value, error) {
+	if d.IsZero() {
+		return Value{}, ErrDivideByZero
+	}
+	if v.IsZero() || m.IsZero() {
+		return Value{}, nil
+	}
+	// enough extra digits that the quotient has more than Precision of them
+	const extra = Precision + 2
+	num := new(big.Int).SetUint64(v.mantissa)
+	num.Mul(num, new(big.Int).SetUint64(m.mantissa))
+	num.Mul(num, pow10(extra))
+	rem := new(big.Int)
+	num.QuoRem(num, new(big.Int).SetUint64(d.mantissa), rem)
+	negative := v.negative != m.negative != d.negative
+	return round(negative, num, v.exponent+m.exponent-d.exponent-extra, rem.Sign() != 0, mode)
+}
+
+// Integer returns v rounded to a whole number under mode
+func (v Value) Integer(mode Rounding) Value {
+	if v.IsZero() || v.exponent >= 0 {
+		return v
+	}
+	d := pow10(-v.exponent)
+	q, rem := new(big.Int).QuoRem(new(big.Int).SetUint64(v.mantissa), d, new(big.Int))
+	if roundsUp(q, rem, d, false, mode) {
+		q.Add(q, bigOne)
+	}
+	// a whole number of at most Precision digits is always in range
+	r, _ := round(v.negative, q, 0, false, RoundDown)
+	return r
+}
+
+// Uint64 returns v as a uint64, and whether v is a whole number in the range
+// of a uint64
+func (v Value) Uint64() (uint64, bool) {
+	if v.negative {
+		return 0, false
+	}
+	if v.exponent >= 0 {
+		b := new(big.Int).SetUint64(v.mantissa)
+		b.Mul(b, pow10(v.exponent))
+		return b.Uint64(), b.IsUint64()
+	}
+	if -v.exponent > Precision {
+		return 0, v.IsZero()
+	}
+	p := pow10(-v.exponent).Uint64()
+	return v.mantissa / p, v.mantissa%p == 0
+}
+
+// Parse reads a decimal number such as "12", "-0.005" or "1.5e-20". Digits
+// beyond Precision are rounded half to even.
+func Parse(s string) (Value, error) {
+	if len(s) > maxParseLength {
+		return Value{}, ErrSyntax
+	}
+	num, exp := s, ""
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		num, exp = s[:i], s[i+1:]
+		if exp == "" {
+			return Value{}, ErrSyntax
+		}
+	}
+
+	negative := false
+	if num != "" && (num[0] == '-' || num[0] == '+') {
+		negative = num[0] == '-'
+		num = num[1:]
+	}
+	whole, frac, _ := strings.Cut(num, ".")
+	digits := whole + frac
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Value{}, ErrSyntax
+	}
+
+	e := 0
+	if exp != "" {
+		n, err := strconv.ParseInt(exp, 10, 16)
+		if err != nil {
+			return Value{}, ErrSyntax
+		}
+		e = int(n)
+	}
+	mag, _ := new(big.Int).SetString(digits, 10)
+	return round(negative, mag, e-len(frac), false, RoundHalfEven)
+}
+
+// String returns the canonical spelling of v: plain decimal notation, without
+// trailing zeros, from 1e-20 up to 1e30, and scientific notation with a
+// single digit before the point otherwise
+func (v Value) String() string {
+	if v.IsZero() {
+		return "0"
+	}
+	digits := strconv.FormatUint(v.mantissa, 10)
+	exp := v.exponent
+	trimmed := strings.TrimRight(digits, "0")
+	exp += len(digits) - len(trimmed)
+	digits = trimmed
+
+	var b strings.Builder
+	if v.negative {
+		b.WriteByte('-')
+	}
+	point := len(digits) + exp // digits before the decimal point
+	switch {
+	case point > 30 || point < -19:
+		b.WriteString(digits[:1])
+		if len(digits) > 1 {
+			b.WriteByte('.')
+			b.WriteString(digits[1:])
+		}
+		b.WriteByte('e')
+		b.WriteString(strconv.Itoa(point - 1))
+	case exp >= 0:
+		b.WriteString(digits)
+		b.WriteString(strings.Repeat("0", exp))
+	case point > 0:
+		b.WriteString(digits[:point])
+		b.WriteByte('.')
+		b.WriteString(digits[point:])
+	default:
+		b.WriteString("0.")
+		b.WriteString(strings.Repeat("0", -point))
+		b.WriteString(digits)
+	}
+	return b.String()
+}
+
+// MarshalJSON encodes v as its canonical string
+func (v Value) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(v.String())), nil
+}
+
+// UnmarshalJSON decodes v from a string or a JSON number
+func (v *Value) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		var err error
+		if s, err = strconv.Unquote(s); err != nil {
+			return ErrSyntax
+		}
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// MarshalBinary returns the canonical encoding of v, empty for zero
+func (v Value) MarshalBinary() ([]byte, error) {
+	if v.IsZero() {
+		return nil, nil
+	}
+	data := make([]byte, valueSize)
+	if v.negative {
+		data[0] = 1
+	}
+	data[1] = byte(v.exponent - MinExponent)
+	binary.BigEndian.PutUint64(data[2:], v.mantissa)
+	return data, nil
+}
+
+// UnmarshalBinary decodes v from its canonical encoding. Encodings of
+// numbers out of range, or not in canonical form, are refused.
+func (v *Value) UnmarshalBinary(data []byte) error {
+	*v = Value{}
+	if len(data) == 0 {
+		return nil
+	}
+	if len(data) != valueSize || data[0] > 1 || int(data[1]) > MaxExponent-MinExponent {
+		return ErrSyntax
+	}
+	mantissa := binary.BigEndian.Uint64(data[2:])
+	if mantissa < MinMantissa || mantissa > MaxMantissa {
+		return ErrSyntax
+	}
+	*v = Value{mantissa: mantissa, exponent: int(data[1]) + MinExponent, negative: data[0] == 1}
+	return nil
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package amount
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func mustParse(t *testing.T, s string) Value {
+	t.Helper()
+	v, err := Parse(s)
+	if err != nil {
+		t.Fatalf("parse %q: %v", s, err)
+	}
+	return v
+}
+
+func TestValueString(t *testing.T) {
+	cases := []struct{ in, want string }{
+		{"0", "0"},
+		{"-0.000", "0"},
+		{"100", "100"},
+		{"+1.50", "1.5"},
+		{"-0.005", "-0.005"},
+		{"12345e-2", "123.45"},
+		{"1e30", "1e30"},
+		{"1e29", "100000000000000000000000000000"},
+		{"2.5e-20", "0.000000000000000000025"},
+		{"2.5e-21", "2.5e-21"},
+		{"1e-19", "0.0000000000000000001"},
+		{"18446744073709551615", "18446744073709551620"},
+		{"1.2345678901234567895", "1.23456789012345679"},
+		{"1.2345678901234567885", "1.234567890123456788"},
+	}
+	for _, c := range cases {
+		if got := mustParse(t, c.in).String(); got != c.want {
+			t.Errorf("%q: got %q, want %q", c.in, got, c.want)
+		}
+		if got := mustParse(t, c.want).String(); got != c.want {
+			t.Errorf("%q does not round trip: got %q", c.want, got)
+		}
+	}
+
+	for _, bad := range []string{"", "-", ".", "1e", "1.2.3", "0x10", "1e99999", " 1"} {
+		if _, err := Parse(bad); !errors.Is(err, ErrSyntax) {
+			t.Errorf("%q: got %v, want ErrSyntax", bad, err)
+		}
+	}
+	if _, err := Parse("1e100"); !errors.Is(err, ErrOverflow) {
+		t.Errorf("1e100: got %v, want ErrOverflow", err)
+	}
+	if v := mustParse(t, "1e-120"); !v.IsZero() {
+		t.Errorf("1e-120: got %v, want 0", v)
+	}
+}
+
+func TestValueArithmetic(t *testing.T) {
+	a, b := mustParse(t, "10.5"), mustParse(t, "-0.25")
+	check := func(name string, got Value, err error, want string) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if got.String() != want {
+			t.Errorf("%s: got %s, want %s", name, got, want)
+		}
+	}
+
+	sum, err := a.Add(b)
+	check("add", sum, err, "10.25")
+	diff, err := b.Sub(a)
+	check("sub", diff, err, "-10.75")
+	prod, err := a.Mul(b, RoundHalfEven)
+	check("mul", prod, err, "-2.625")
+
+	third, err := FromUint64(1).Div(FromUint64(3), RoundHalfEven)
+	check("1/3", third, err, "0.3333333333333333333")
+	up, err := FromUint64(2).Div(FromUint64(3), RoundUp)
+	check("2/3 up", up, err, "0.6666666666666666667")
+	down, err := FromUint64(2).Div(FromUint64(3), RoundDown)
+	check("2/3 down", down, err, "0.6666666666666666666")
+	neg, err := FromUint64(2).Neg().Div(FromUint64(3), RoundDown)
+	check("-2/3 down", neg, err, "-0.6666666666666666666")
+
+	// computed exactly before rounding, unlike a division then a product
+	md, err := FromUint64(10).MulDiv(FromUint64(3), FromUint64(3), RoundUp)
+	check("10*3/3", md, err, "10")
+
+	// the smaller operand only moves the last digit
+	big := mustParse(t, "1e20")
+	s, err := big.Add(FromUint64(1))
+	check("1e20+1", s, err, "100000000000000000000")
+	s, err = big.Add(mustParse(t, "60"))
+	check("1e20+60", s, err, "100000000000000000100")
+
+	if _, err := a.Div(Value{}, RoundDown); !errors.Is(err, ErrDivideByZero) {
+		t.Errorf("division by zero: got %v", err)
+	}
+	if _, err := mustParse(t, "9e98").Mul(mustParse(t, "10"), RoundDown); !errors.Is(err, ErrOverflow) {
+		t.Errorf("overflow: got %v", err)
+	}
+
+	if got := mustParse(t, "2.5").Integer(RoundHalfEven); got.String() != "2" {
+		t.Errorf("2.5 to even: got %s", got)
+	}
+	if got := mustParse(t, "0.001").Integer(RoundUp); got.String() != "1" {
+		t.Errorf("0.001 up: got %s", got)
+	}
+	if got := mustParse(t, "-7.9").Integer(RoundDown); got.String() != "-7" {
+		t.Errorf("-7.9 down: got %s", got)
+	}
+}
+
+func TestValueCompare(t *testing.T) {
+	sorted := []string{"-1e10", "-3", "-0.5", "0", "1e-70", "0.5", "3", "10", "1e10"}
+	for i, x := range sorted {
+		for j, y := range sorted {
+			want := cmpInt(i, j)
+			if got := mustParse(t, x).Cmp(mustParse(t, y)); got != want {
+				t.Errorf("cmp(%s, %s) = %d, want %d", x, y, got, want)
+			}
+		}
+	}
+	if got := Min(FromUint64(5), FromUint64(2), FromUint64(9)); got != FromUint64(2) {
+		t.Errorf("min: got %s", got)
+	}
+
+	if v, ok := FromUint64(1_000_000).Uint64(); !ok || v != 1_000_000 {
+		t.Errorf("got %d, %v", v, ok)
+	}
+	if _, ok := mustParse(t, "1.5").Uint64(); ok {
+		t.Error("1.5 is not a whole number")
+	}
+	if _, ok := mustParse(t, "-1").Uint64(); ok {
+		t.Error("-1 is not a uint64")
+	}
+}
+
+func TestValueEncoding(t *testing.T) {
+	for _, s := range []string{"0", "1", "-123.456", "1e-78", "9.999999999999999999e98"} {
+		v := mustParse(t, s)
+		data, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Value
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("%s: %v", s, err)
+		}
+		if got != v {
+			t.Errorf("%s: got %s", s, got)
+		}
+
+		js, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var fromJSON Value
+		if err := json.Unmarshal(js, &fromJSON); err != nil || fromJSON != v {
+			t.Errorf("%s: got %s from %s, %v", s, fromJSON, js, err)
+		}
+	}
+
+	var n Value
+	if err := json.Unmarshal([]byte("250"), &n); err != nil || n != FromUint64(250) {
+		t.Errorf("JSON number: got %s, %v", n, err)
+	}
+
+	// a mantissa without Precision digits is not canonical
+	bad := []byte{0, 96, 0, 0, 0, 0, 0, 0, 0, 1}
+	if err := new(Value).UnmarshalBinary(bad); !errors.Is(err, ErrSyntax) {
+		t.Errorf("non canonical encoding: got %v", err)
+	}
+}
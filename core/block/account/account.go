@@ -18,22 +18,192 @@
 package account
 
 import (
+	"fmt"
 	"github.com/ezcon-foundation/go-ezcon/core/block/account/asset"
 	"github.com/ezcon-foundation/go-ezcon/core/block/account/kyc"
 	"github.com/ezcon-foundation/go-ezcon/core/block/account/trustline"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+	"math"
 	"time"
 )
 
+// Account flags, set and cleared with AccountSet
+const (
+	// FlagRequireAuth makes the account confirm again any trust line toward
+	// it whose limit is raised
+	FlagRequireAuth uint32 = 1 << iota
+	// FlagRequireDestTag refuses payments without a destination tag
+	FlagRequireDestTag
+	// FlagDisallowIncomingTrustlines refuses new trust lines toward the account
+	FlagDisallowIncomingTrustlines
+	// FlagRequireKYCForCounterparties only lets KYC verified accounts pay,
+	// be paid, trade or hold trust lines with the account
+	FlagRequireKYCForCounterparties
+	// FlagDefaultRipple lets new trust lines toward the account ripple
+	FlagDefaultRipple
+	// FlagGlobalFreeze stops every currency issued by the account from
+	// moving between holders
+	FlagGlobalFreeze
+	// FlagDisableMaster refuses transactions signed with the master key, so
+	// that only the signer list can sign for the account
+	FlagDisableMaster
+
+	// AllFlags are the flags AccountSet may change
+	AllFlags = FlagRequireAuth | FlagRequireDestTag | FlagDisallowIncomingTrustlines |
+		FlagRequireKYCForCounterparties | FlagDefaultRipple | FlagGlobalFreeze | FlagDisableMaster
+)
+
+// Transfer rates are in billionths: TransferRateParity charges no fee and
+// MaxTransferRate doubles the amount sent
+const (
+	TransferRateParity uint32 = 1_000_000_000
+	MaxTransferRate    uint32 = 2_000_000_000
+)
+
 // Account represents a user account
 type Account struct {
 	AccountID    string                `json:"account_id"`
 	Balance      uint64                `json:"balance"`
 	Sequence     uint32                `json:"sequence"`
-	Reserve      uint64                `json:"reserve"`
+	Flags        uint32                `json:"flags"`
+	Domain       string                `json:"domain"`
+	MessageKey   string                `json:"message_key"`   // Hex encoded public key for encrypted messages
+	TransferRate uint32                `json:"transfer_rate"` // Fee on transfers of issued currencies, zero for none
 	KYCData      kyc.KYCData           `json:"kyc_data"`
 	KYCHash      []byte                `json:"kyc_hash"`
 	KYCVerified  bool                  `json:"kyc_verified"`
 	KYCTimestamp time.Time             `json:"kyc_timestamp"`
 	TrustLines   []trustline.TrustLine `json:"trust_lines"`
 	Assets       []asset.Asset         `json:"assets"`
+
+	// SignerQuorum is the total weight of signers needed to multi-sign for
+	// the account, zero when it has no signer list
+	SignerQuorum  uint32        `json:"signer_quorum"`
+	SignerEntries []SignerEntry `json:"signer_entries"`
+
+	// OwnerCount is the number of ledger objects, such as escrows, that the
+	// account owns outside of its own entry
+	OwnerCount uint32 `json:"owner_count"`
+}
+
+// HasFlag reports whether every flag of flags is set
+func (a *Account) HasFlag(flags uint32) bool {
+	return a.Flags&flags == flags
+}
+
+// MarshalBinary returns the canonical encoding of the account
+func (a *Account) MarshalBinary() ([]byte, error) {
+	kycData, err := a.KYCData.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	trustLines := make([][]byte, len(a.TrustLines))
+	for i := range a.TrustLines {
+		if trustLines[i], err = a.TrustLines[i].MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+
+	assets := make([][]byte, len(a.Assets))
+	for i := range a.Assets {
+		if assets[i], err = a.Assets[i].MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+
+	signers := make([][]byte, len(a.SignerEntries))
+	for i := range a.SignerEntries {
+		if signers[i], err = a.SignerEntries[i].MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+
+	e := codec.NewEncoder()
+	e.Uint32(codec.FieldFlags, a.Flags)
+	e.Uint32(codec.FieldTransferRate, a.TransferRate)
+	e.Uint32(codec.FieldSignerQuorum, a.SignerQuorum)
+	e.Uint32(codec.FieldOwnerCount, a.OwnerCount)
+	e.Uint64(codec.FieldSequence, uint64(a.Sequence))
+	e.Uint64(codec.FieldBalance, a.Balance)
+	e.Bool(codec.FieldKYCVerified, a.KYCVerified)
+	e.Time(codec.FieldKYCTimestamp, a.KYCTimestamp)
+	e.Blob(codec.FieldKYCHash, a.KYCHash)
+	e.String(codec.FieldAccount, a.AccountID)
+	e.String(codec.FieldDomain, a.Domain)
+	e.String(codec.FieldMessageKey, a.MessageKey)
+	e.Object(codec.FieldKYCData, kycData)
+	e.Array(codec.FieldTrustLines, trustLines)
+	e.Array(codec.FieldAssets, assets)
+	e.Array(codec.FieldSignerList, signers)
+	return e.Bytes()
+}
+
+// UnmarshalBinary decodes an account from its canonical encoding
+func (a *Account) UnmarshalBinary(data []byte) error {
+	*a = Account{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldFlags:
+			a.Flags = d.Uint32()
+		case codec.FieldTransferRate:
+			a.TransferRate = d.Uint32()
+		case codec.FieldSignerQuorum:
+			a.SignerQuorum = d.Uint32()
+		case codec.FieldOwnerCount:
+			a.OwnerCount = d.Uint32()
+		case codec.FieldSequence:
+			seq := d.Uint64()
+			if seq > math.MaxUint32 {
+				return fmt.Errorf("account: sequence %d out of range", seq)
+			}
+			a.Sequence = uint32(seq)
+		case codec.FieldBalance:
+			a.Balance = d.Uint64()
+		case codec.FieldKYCVerified:
+			a.KYCVerified = d.Bool()
+		case codec.FieldKYCTimestamp:
+			a.KYCTimestamp = d.Time()
+		case codec.FieldKYCHash:
+			a.KYCHash = d.Blob()
+		case codec.FieldAccount:
+			a.AccountID = d.String()
+		case codec.FieldDomain:
+			a.Domain = d.String()
+		case codec.FieldMessageKey:
+			a.MessageKey = d.String()
+		case codec.FieldKYCData:
+			if err := a.KYCData.UnmarshalBinary(d.Object()); err != nil {
+				return err
+			}
+		case codec.FieldTrustLines:
+			for _, item := range d.Array() {
+				var line trustline.TrustLine
+				if err := line.UnmarshalBinary(item); err != nil {
+					return err
+				}
+				a.TrustLines = append(a.TrustLines, line)
+			}
+		case codec.FieldAssets:
+			for _, item := range d.Array() {
+				var as asset.Asset
+				if err := as.UnmarshalBinary(item); err != nil {
+					return err
+				}
+				a.Assets = append(a.Assets, as)
+			}
+		case codec.FieldSignerList:
+			for _, item := range d.Array() {
+				var entry SignerEntry
+				if err := entry.UnmarshalBinary(item); err != nil {
+					return err
+				}
+				a.SignerEntries = append(a.SignerEntries, entry)
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
 }
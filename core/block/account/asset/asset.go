@@ -17,11 +17,60 @@
 
 package asset
 
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
 type Asset struct {
-	Type        AssetType `json:"type"`
-	ID          string    `json:"id"`
-	Description string    `json:"description"`
-	Value       uint64    `json:"value"`
-	LegalHash   []byte    `json:"legal_hash"`
-	IsTokenized bool      `json:"is_tokenized"`
+	Type        AssetType    `json:"type"`
+	ID          string       `json:"id"`
+	Description string       `json:"description"`
+	Value       amount.Value `json:"value"`
+	LegalHash   []byte       `json:"legal_hash"`
+	IsTokenized bool         `json:"is_tokenized"`
+}
+
+// MarshalBinary returns the canonical encoding of the asset
+func (a *Asset) MarshalBinary() ([]byte, error) {
+	value, err := a.Value.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	e := codec.NewEncoder()
+	e.Uint32(codec.FieldAssetType, uint32(a.Type))
+	e.Bool(codec.FieldIsTokenized, a.IsTokenized)
+	e.Blob(codec.FieldLegalHash, a.LegalHash)
+	e.Blob(codec.FieldAmountValue, value)
+	e.String(codec.FieldID, a.ID)
+	e.String(codec.FieldDescription, a.Description)
+	return e.Bytes()
+}
+
+// UnmarshalBinary decodes an asset from its canonical encoding
+func (a *Asset) UnmarshalBinary(data []byte) error {
+	*a = Asset{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldAssetType:
+			a.Type = AssetType(d.Uint32())
+		case codec.FieldAmountValue:
+			if err := a.Value.UnmarshalBinary(d.Blob()); err != nil {
+				return err
+			}
+		case codec.FieldIsTokenized:
+			a.IsTokenized = d.Bool()
+		case codec.FieldLegalHash:
+			a.LegalHash = d.Blob()
+		case codec.FieldID:
+			a.ID = d.String()
+		case codec.FieldDescription:
+			a.Description = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
 }
@@ -24,9 +24,9 @@ import (
 
 type AssetType int
 
-// Asset transaction
+// Asset transaction. Values start at 1 so that the zero value means unset
 const (
-	AssetTypeRealEstate AssetType = iota
+	AssetTypeRealEstate AssetType = iota + 1
 	AssetTypeVehicle
 	AssetTypeArt
 	AssetTypeCollectible
@@ -16,3 +16,34 @@
  */
 
 package kyc
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+	"github.com/ezcon-foundation/go-ezcon/crypto"
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+)
+
+// hashPrefixAttestation phân biệt chữ ký của KYC provider với các loại chữ ký khác
+var hashPrefixAttestation = []byte("KYC\x00")
+
+// AttestationData trả về message mà KYC provider ký để xác nhận tài khoản
+// account đã được KYC với dữ liệu có hash kycHash
+func AttestationData(account string, kycHash []byte) []byte {
+	e := codec.NewEncoder()
+	e.Blob(codec.FieldKYCHash, kycHash)
+	e.String(codec.FieldAccount, account)
+
+	// encoder chỉ lỗi khi trùng field, điều không thể xảy ra ở đây
+	data, _ := e.Bytes()
+	return append(append([]byte(nil), hashPrefixAttestation...), data...)
+}
+
+// Sign tạo chữ ký xác nhận KYC của provider cho tài khoản
+func Sign(priv crypto.PrivateKey, account string, kycHash []byte) []byte {
+	return keys.Sign(priv, AttestationData(account, kycHash))
+}
+
+// Verify kiểm tra chữ ký xác nhận KYC theo public key (đã mã hoá) của provider
+func Verify(providerKey []byte, account string, kycHash, sig []byte) bool {
+	return keys.Verify(providerKey, AttestationData(account, kycHash), sig)
+}
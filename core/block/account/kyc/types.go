@@ -18,8 +18,9 @@
 package kyc
 
 import (
-	"encoding/json"
-	"time"
+	"crypto/sha256"
+
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
 )
 
 // KYCData chứa thông tin KYC của người dùng
@@ -33,19 +34,58 @@ type KYCData struct {
 	IsEncrypted   bool   `json:"is_encrypted"`   // Dữ liệu có mã hóa không
 }
 
-// KYCSet là giao dịch để thiết lập/cập nhật KYC
-type KYCSet struct {
-	Account      string    `json:"account"`       // Địa chỉ tài khoản
-	KYCData      KYCData   `json:"kyc_data"`      // Thông tin KYC (mã hóa hoặc rỗng)
-	KYCHash      []byte    `json:"kyc_hash"`      // Hash của KYCData (SHA-256)
-	KYCSignature []byte    `json:"kyc_signature"` // Chữ ký của KYC provider
-	Sequence     uint32    `json:"sequence"`      // Số thứ tự giao dịch
-	Fee          uint64    `json:"fee"`           // Phí giao dịch (drops)
-	Timestamp    time.Time `json:"timestamp"`     // Thời gian gửi
-	Signature    []byte    `json:"signature"`     // Chữ ký tài khoản
+// MarshalBinary trả về mã hoá chuẩn (canonical) của KYCData
+func (k *KYCData) MarshalBinary() ([]byte, error) {
+	e := codec.NewEncoder()
+	e.Bool(codec.FieldIsEncrypted, k.IsEncrypted)
+	e.Blob(codec.FieldBiometricHash, k.BiometricHash)
+	e.String(codec.FieldFullName, k.FullName)
+	e.String(codec.FieldIDNumber, k.IDNumber)
+	e.String(codec.FieldDateOfBirth, k.DateOfBirth)
+	e.String(codec.FieldNationality, k.Nationality)
+	e.String(codec.FieldAddress, k.Address)
+	return e.Bytes()
+}
+
+// UnmarshalBinary giải mã KYCData từ mã hoá chuẩn
+func (k *KYCData) UnmarshalBinary(data []byte) error {
+	*k = KYCData{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldIsEncrypted:
+			k.IsEncrypted = d.Bool()
+		case codec.FieldBiometricHash:
+			k.BiometricHash = d.Blob()
+		case codec.FieldFullName:
+			k.FullName = d.String()
+		case codec.FieldIDNumber:
+			k.IDNumber = d.String()
+		case codec.FieldDateOfBirth:
+			k.DateOfBirth = d.String()
+		case codec.FieldNationality:
+			k.Nationality = d.String()
+		case codec.FieldAddress:
+			k.Address = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+// IsEmpty cho biết KYCData không chứa thông tin nào
+func (k *KYCData) IsEmpty() bool {
+	return k.FullName == "" && k.IDNumber == "" && k.DateOfBirth == "" && k.Nationality == "" &&
+		k.Address == "" && len(k.BiometricHash) == 0 && !k.IsEncrypted
 }
 
-// Serialize KYCSet để ký hoặc lưu trữ
-func (k *KYCSet) Serialize() ([]byte, error) {
-	return json.Marshal(k)
+// Hash trả về hash SHA-256 của mã hoá chuẩn KYCData, được lưu ở Account.KYCHash
+func (k *KYCData) Hash() ([]byte, error) {
+	data, err := k.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], nil
 }
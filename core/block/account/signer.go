@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package account
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// MaxSignerEntries bounds the size of a signer list
+const MaxSignerEntries = 32
+
+// SignerEntry is an account allowed to sign for another one, with the weight
+// its signature counts for toward the quorum
+type SignerEntry struct {
+	Account string `json:"account"`
+	Weight  uint32 `json:"weight"`
+}
+
+// MarshalBinary returns the canonical encoding of the signer entry
+func (s *SignerEntry) MarshalBinary() ([]byte, error) {
+	e := codec.NewEncoder()
+	e.Uint32(codec.FieldSignerWeight, s.Weight)
+	e.String(codec.FieldAccount, s.Account)
+	return e.Bytes()
+}
+
+// UnmarshalBinary decodes a signer entry from its canonical encoding
+func (s *SignerEntry) UnmarshalBinary(data []byte) error {
+	*s = SignerEntry{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldSignerWeight:
+			s.Weight = d.Uint32()
+		case codec.FieldAccount:
+			s.Account = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+// SignerWeight returns the weight of signer in the signer list of the
+// account, or zero if it is not listed
+func (a *Account) SignerWeight(signer string) uint32 {
+	for _, entry := range a.SignerEntries {
+		if entry.Account == signer {
+			return entry.Weight
+		}
+	}
+	return 0
+}
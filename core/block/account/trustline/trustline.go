@@ -17,18 +17,91 @@
 
 package trustline
 
-import "time"
+import (
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// Trust line flags
+const (
+	// FlagNoRipple stops payments between holders from rippling through the line
+	FlagNoRipple uint32 = 1 << iota
+)
 
 // TrustLine defines a trust relationship between two accounts
 type TrustLine struct {
-	Account    string    `json:"account"`     // Counterparty account
-	Currency   string    `json:"currency"`    // EZC, USD, REALESTATE, IP, etc.
-	Limit      uint64    `json:"limit"`       // Trust limit
-	Balance    int64     `json:"balance"`     // Current balance
-	QualityIn  uint32    `json:"quality_in"`  // Inbound rate
-	QualityOut uint32    `json:"quality_out"` // Outbound rate
-	Flags      uint32    `json:"flags"`       // NoRipple, Authorized, etc.
-	IsVerified bool      `json:"is_verified"` // Mutually confirmed
-	ExpiresAt  time.Time `json:"expires_at"`  // Expiration time
-	Conditions []string  `json:"conditions"`  // e.g., ["only_token:REALESTATE:NFT123"]
+	Account    string       `json:"account"`     // Counterparty account
+	Currency   string       `json:"currency"`    // EZC, USD, REALESTATE, IP, etc.
+	Limit      amount.Value `json:"limit"`       // Trust limit
+	Balance    amount.Value `json:"balance"`     // Current balance, negative if owed
+	QualityIn  uint32       `json:"quality_in"`  // Inbound rate
+	QualityOut uint32       `json:"quality_out"` // Outbound rate
+	Flags      uint32       `json:"flags"`       // NoRipple, Authorized, etc.
+	IsVerified bool         `json:"is_verified"` // Mutually confirmed
+	ExpiresAt  time.Time    `json:"expires_at"`  // Expiration time
+	Conditions []string     `json:"conditions"`  // e.g., ["only_token:REALESTATE:NFT123"]
+}
+
+// MarshalBinary returns the canonical encoding of the trust line
+func (t *TrustLine) MarshalBinary() ([]byte, error) {
+	limit, err := t.Limit.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	balance, err := t.Balance.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	e := codec.NewEncoder()
+	e.Uint32(codec.FieldFlags, t.Flags)
+	e.Uint32(codec.FieldQualityIn, t.QualityIn)
+	e.Uint32(codec.FieldQualityOut, t.QualityOut)
+	e.Bool(codec.FieldIsVerified, t.IsVerified)
+	e.Time(codec.FieldExpiresAt, t.ExpiresAt)
+	e.Blob(codec.FieldLimitValue, limit)
+	e.Blob(codec.FieldBalanceValue, balance)
+	e.String(codec.FieldAccount, t.Account)
+	e.String(codec.FieldCurrency, t.Currency)
+	e.StringArray(codec.FieldConditions, t.Conditions)
+	return e.Bytes()
+}
+
+// UnmarshalBinary decodes a trust line from its canonical encoding
+func (t *TrustLine) UnmarshalBinary(data []byte) error {
+	*t = TrustLine{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldFlags:
+			t.Flags = d.Uint32()
+		case codec.FieldQualityIn:
+			t.QualityIn = d.Uint32()
+		case codec.FieldQualityOut:
+			t.QualityOut = d.Uint32()
+		case codec.FieldIsVerified:
+			t.IsVerified = d.Bool()
+		case codec.FieldExpiresAt:
+			t.ExpiresAt = d.Time()
+		case codec.FieldLimitValue:
+			if err := t.Limit.UnmarshalBinary(d.Blob()); err != nil {
+				return err
+			}
+		case codec.FieldBalanceValue:
+			if err := t.Balance.UnmarshalBinary(d.Blob()); err != nil {
+				return err
+			}
+		case codec.FieldAccount:
+			t.Account = d.String()
+		case codec.FieldCurrency:
+			t.Currency = d.String()
+		case codec.FieldConditions:
+			t.Conditions = d.StringArray()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
 }
@@ -18,7 +18,10 @@
 package block
 
 import (
+	"crypto/sha256"
 	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
 )
 
 // Block thể hiện trạng thái của một block trọng mạng blockchain ezcon
@@ -40,23 +43,14 @@ type BlockHeader struct {
 	// ParentHash là hash của block trước đó
 	ParentHash []byte `json:"parent_hash"`
 
-	//
-	StateHash  []byte    `json:"state_hash"`
-	TotalCoins uint64    `json:"total_coins"`
-	CloseTime  time.Time `json:"close_time"`
-}
+	// StateHash là root hash của cây trạng thái (Accounts)
+	StateHash []byte `json:"state_hash"`
 
-type SHAMap struct {
-	RootHash []byte          `json:"root_hash"` // Root hash of Merkle Tree
-	Nodes    map[string]Node `json:"nodes"`
-}
+	// TxHash là root hash của cây giao dịch (Transactions)
+	TxHash []byte `json:"tx_hash"`
 
-type Node struct {
-	Hash   []byte `json:"hash"` // Hash của node
-	Data   []byte `json:"data"`
-	Left   string `json:"left"`
-	Right  string `json:"right"`
-	IsLeaf bool   `json:"is_leaf"`
+	TotalCoins uint64    `json:"total_coins"`
+	CloseTime  time.Time `json:"close_time"`
 }
 
 func NewBlock(index uint64, parentHash []byte, totalCoins uint64) *Block {
@@ -68,3 +62,65 @@ func NewBlock(index uint64, parentHash []byte, totalCoins uint64) *Block {
 		},
 	}
 }
+
+// UpdateRoots tính lại StateHash và TxHash từ cây trạng thái và cây giao dịch
+func (b *Block) UpdateRoots() {
+	b.Header.StateHash = b.Accounts.RootHash()
+	b.Header.TxHash = b.Transactions.RootHash()
+}
+
+// encode thêm các trường của header vào encoder, trừ Hash
+func (h *BlockHeader) encode(e *codec.Encoder) {
+	e.Uint64(codec.FieldIndex, h.Index)
+	e.Uint64(codec.FieldTotalCoins, h.TotalCoins)
+	e.Time(codec.FieldCloseTime, h.CloseTime)
+	e.Blob(codec.FieldParentHash, h.ParentHash)
+	e.Blob(codec.FieldStateHash, h.StateHash)
+	e.Blob(codec.FieldTxHash, h.TxHash)
+}
+
+// ComputeHash tính hash của header trên mã hoá chuẩn (canonical) của các trường, không gồm Hash
+func (h *BlockHeader) ComputeHash() []byte {
+	e := codec.NewEncoder()
+	h.encode(e)
+
+	// encoder chỉ lỗi khi trùng field, điều không thể xảy ra ở đây
+	data, _ := e.Bytes()
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+// MarshalBinary trả về mã hoá chuẩn của header, bao gồm Hash
+func (h *BlockHeader) MarshalBinary() ([]byte, error) {
+	e := codec.NewEncoder()
+	h.encode(e)
+	e.Blob(codec.FieldHash, h.Hash)
+	return e.Bytes()
+}
+
+// UnmarshalBinary giải mã header từ mã hoá chuẩn
+func (h *BlockHeader) UnmarshalBinary(data []byte) error {
+	*h = BlockHeader{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldIndex:
+			h.Index = d.Uint64()
+		case codec.FieldTotalCoins:
+			h.TotalCoins = d.Uint64()
+		case codec.FieldCloseTime:
+			h.CloseTime = d.Time()
+		case codec.FieldHash:
+			h.Hash = d.Blob()
+		case codec.FieldParentHash:
+			h.ParentHash = d.Blob()
+		case codec.FieldStateHash:
+			h.StateHash = d.Blob()
+		case codec.FieldTxHash:
+			h.TxHash = d.Blob()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
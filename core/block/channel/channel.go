@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package channel defines unidirectional payment channels and the off-ledger
+// claims that pay out of them
+package channel
+
+import (
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// Channel holds native funds of its owner for Destination. The owner signs
+// claims off the ledger with the key PublicKey, each for the total amount
+// the destination may take so far, and the destination redeems the latest
+// one with a PaymentChannelClaim.
+//
+// The owner can only close a channel that still holds funds by setting
+// Expiration at least SettleDelay ahead, which leaves the destination time
+// to redeem its last claim. Once expired, the next claim returns what is
+// left to the owner.
+type Channel struct {
+	Account     string    `json:"account"`      // Owner of the channel
+	Sequence    uint64    `json:"sequence"`     // Sequence of the PaymentChannelCreate
+	Destination string    `json:"destination"`  // Receiver of the claims
+	Amount      uint64    `json:"amount"`       // Total drops put in the channel
+	Balance     uint64    `json:"balance"`      // Drops already paid to the destination
+	PublicKey   string    `json:"public_key"`   // Hex key signing claims
+	SettleDelay uint32    `json:"settle_delay"` // Seconds the owner must wait to close it
+	Expiration  time.Time `json:"expiration"`   // Zero until the owner asks to close it
+	CancelAfter time.Time `json:"cancel_after"` // Zero if it has no fixed end
+}
+
+// Remaining returns the drops the destination can still claim
+func (c *Channel) Remaining() uint64 {
+	return c.Amount - c.Balance
+}
+
+// Expired reports whether the channel can only be closed at t
+func (c *Channel) Expired(t time.Time) bool {
+	return (!c.Expiration.IsZero() && t.After(c.Expiration)) ||
+		(!c.CancelAfter.IsZero() && t.After(c.CancelAfter))
+}
+
+// SettleTime returns the earliest expiration the owner may set at t
+func (c *Channel) SettleTime(t time.Time) time.Time {
+	return t.Add(time.Duration(c.SettleDelay) * time.Second)
+}
+
+// MarshalBinary returns the canonical encoding of the channel
+func (c *Channel) MarshalBinary() ([]byte, error) {
+	enc := codec.NewEncoder()
+	enc.Uint32(codec.FieldSettleDelay, c.SettleDelay)
+	enc.Uint64(codec.FieldSequence, c.Sequence)
+	enc.Uint64(codec.FieldBalance, c.Balance)
+	enc.Uint64(codec.FieldValue, c.Amount)
+	enc.Time(codec.FieldCancelAfter, c.CancelAfter)
+	enc.Time(codec.FieldExpiration, c.Expiration)
+	enc.String(codec.FieldAccount, c.Account)
+	enc.String(codec.FieldDestination, c.Destination)
+	enc.String(codec.FieldPublicKey, c.PublicKey)
+	return enc.Bytes()
+}
+
+// UnmarshalBinary decodes a channel from its canonical encoding
+func (c *Channel) UnmarshalBinary(data []byte) error {
+	*c = Channel{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldSettleDelay:
+			c.SettleDelay = d.Uint32()
+		case codec.FieldSequence:
+			c.Sequence = d.Uint64()
+		case codec.FieldBalance:
+			c.Balance = d.Uint64()
+		case codec.FieldValue:
+			c.Amount = d.Uint64()
+		case codec.FieldCancelAfter:
+			c.CancelAfter = d.Time()
+		case codec.FieldExpiration:
+			c.Expiration = d.Time()
+		case codec.FieldAccount:
+			c.Account = d.String()
+		case codec.FieldDestination:
+			c.Destination = d.String()
+		case codec.FieldPublicKey:
+			c.PublicKey = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
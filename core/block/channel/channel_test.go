@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package channel
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+)
+
+func TestClaimSignature(t *testing.T) {
+	pub, priv, err := keys.GenerateKey(keys.DefaultScheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey, err := keys.MarshalPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := hex.EncodeToString(pubKey)
+
+	claim := Claim{Account: "alice", Sequence: 4, Amount: 1500}
+	sig, err := claim.Sign(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !claim.Verify(publicKey, sig) {
+		t.Fatal("valid claim refused")
+	}
+
+	for _, other := range []Claim{
+		{Account: "alice", Sequence: 4, Amount: 1501},
+		{Account: "alice", Sequence: 5, Amount: 1500},
+		{Account: "bob", Sequence: 4, Amount: 1500},
+		{NetworkID: 2, Account: "alice", Sequence: 4, Amount: 1500},
+	} {
+		if other.Verify(publicKey, sig) {
+			t.Fatalf("signature accepted for %+v", other)
+		}
+	}
+	if claim.Verify(publicKey, "") || claim.Verify("", sig) {
+		t.Fatal("claim accepted without key or signature")
+	}
+}
+
+func TestChannelRoundTrip(t *testing.T) {
+	c := &Channel{
+		Account:     "alice",
+		Sequence:    4,
+		Destination: "bob",
+		Amount:      2000,
+		Balance:     1500,
+		PublicKey:   "00ab",
+		SettleDelay: 3600,
+		Expiration:  time.Unix(1_700_003_600, 0).UTC(),
+		CancelAfter: time.Unix(1_700_086_400, 0).UTC(),
+	}
+	data, err := c.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Channel
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, c) {
+		t.Fatalf("got %+v, want %+v", got, c)
+	}
+
+	if c.Remaining() != 500 {
+		t.Fatalf("got remaining %d", c.Remaining())
+	}
+	if c.Expired(c.Expiration) || !c.Expired(c.Expiration.Add(time.Second)) {
+		t.Fatal("channel should expire only after its expiration")
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package channel
+
+import (
+	"encoding/hex"
+
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+	"github.com/ezcon-foundation/go-ezcon/crypto"
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+)
+
+// hashPrefixClaim keeps claim signatures apart from transaction signatures
+var hashPrefixClaim = []byte("CLM\x00")
+
+// Claim authorizes the destination of the channel Account created with
+// Sequence to take Amount drops in total out of it. Claims are signed and
+// exchanged off the ledger; only the last one needs to be redeemed.
+// NetworkID keeps a claim from being redeemed on another network.
+type Claim struct {
+	NetworkID uint32 `json:"network_id"`
+	Account   string `json:"account"`
+	Sequence  uint64 `json:"sequence"`
+	Amount    uint64 `json:"amount"`
+}
+
+// SigningData returns the message the channel key signs: the canonical
+// encoding of the claim after a fixed prefix
+func (c Claim) SigningData() ([]byte, error) {
+	enc := codec.NewEncoder()
+	enc.Uint32(codec.FieldNetworkID, c.NetworkID)
+	enc.Uint64(codec.FieldSequence, c.Sequence)
+	enc.Uint64(codec.FieldValue, c.Amount)
+	enc.String(codec.FieldAccount, c.Account)
+	data, err := enc.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte(nil), hashPrefixClaim...), data...), nil
+}
+
+// Sign returns the hex signature of the claim by priv
+func (c Claim) Sign(priv crypto.PrivateKey) (string, error) {
+	data, err := c.SigningData()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(keys.Sign(priv, data)), nil
+}
+
+// Verify reports whether signature is a valid signature of the claim by the
+// hex public key
+func (c Claim) Verify(publicKey, signature string) bool {
+	pubKey, ok := codec.DecodeHex(publicKey)
+	if !ok {
+		return false
+	}
+	sig, ok := codec.DecodeHex(signature)
+	if !ok {
+		return false
+	}
+	data, err := c.SigningData()
+	if err != nil {
+		return false
+	}
+	return keys.Verify(pubKey, data, sig)
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package escrow defines the escrows that hold funds until they are released
+// to their destination or returned to their owner
+package escrow
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// ConditionSize is the size of a condition, the SHA-256 hash of the
+// preimage that fulfils it
+const ConditionSize = sha256.Size
+
+// Escrow holds Amount, taken from its owner, for Destination. It can be
+// finished once FinishAfter has passed and its Condition is fulfilled, and
+// cancelled back to the owner once CancelAfter has passed.
+type Escrow struct {
+	Account     string        `json:"account"`      // Owner of the escrow
+	Sequence    uint64        `json:"sequence"`     // Sequence of the EscrowCreate
+	Destination string        `json:"destination"`  // Receiver of the amount
+	Amount      amount.Amount `json:"amount"`       // Amount held
+	FinishAfter time.Time     `json:"finish_after"` // Zero if it can be finished at once
+	CancelAfter time.Time     `json:"cancel_after"` // Zero if it cannot be cancelled
+	Condition   []byte        `json:"condition"`    // SHA-256 of the preimage, or empty
+}
+
+// Fulfils reports whether preimage fulfils the condition of the escrow. An
+// escrow without a condition takes no preimage.
+func (e *Escrow) Fulfils(preimage []byte) bool {
+	if len(e.Condition) == 0 {
+		return len(preimage) == 0
+	}
+	hash := sha256.Sum256(preimage)
+	return bytes.Equal(hash[:], e.Condition)
+}
+
+// CanFinish reports whether the escrow can be finished at t
+func (e *Escrow) CanFinish(t time.Time) bool {
+	if !e.FinishAfter.IsZero() && !t.After(e.FinishAfter) {
+		return false
+	}
+	return !e.Expired(t)
+}
+
+// Expired reports whether the escrow can only be cancelled at t
+func (e *Escrow) Expired(t time.Time) bool {
+	return !e.CancelAfter.IsZero() && t.After(e.CancelAfter)
+}
+
+// MarshalBinary returns the canonical encoding of the escrow
+func (e *Escrow) MarshalBinary() ([]byte, error) {
+	amt, err := e.Amount.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	enc := codec.NewEncoder()
+	enc.Uint64(codec.FieldSequence, e.Sequence)
+	enc.Time(codec.FieldFinishAfter, e.FinishAfter)
+	enc.Time(codec.FieldCancelAfter, e.CancelAfter)
+	enc.Blob(codec.FieldCondition, e.Condition)
+	enc.String(codec.FieldAccount, e.Account)
+	enc.String(codec.FieldDestination, e.Destination)
+	enc.Object(codec.FieldAmount, amt)
+	return enc.Bytes()
+}
+
+// UnmarshalBinary decodes an escrow from its canonical encoding
+func (e *Escrow) UnmarshalBinary(data []byte) error {
+	*e = Escrow{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldSequence:
+			e.Sequence = d.Uint64()
+		case codec.FieldFinishAfter:
+			e.FinishAfter = d.Time()
+		case codec.FieldCancelAfter:
+			e.CancelAfter = d.Time()
+		case codec.FieldCondition:
+			e.Condition = d.Blob()
+		case codec.FieldAccount:
+			e.Account = d.String()
+		case codec.FieldDestination:
+			e.Destination = d.String()
+		case codec.FieldAmount:
+			if err := e.Amount.UnmarshalBinary(d.Object()); err != nil {
+				return err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package escrow
+
+import (
+	"crypto/sha256"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+)
+
+func TestEscrowRelease(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	condition := sha256.Sum256([]byte("secret"))
+	e := &Escrow{FinishAfter: now, CancelAfter: now.Add(time.Hour), Condition: condition[:]}
+
+	if e.CanFinish(now) || !e.CanFinish(now.Add(time.Second)) {
+		t.Fatal("escrow should finish only after FinishAfter")
+	}
+	if e.Expired(now.Add(time.Hour)) || !e.Expired(now.Add(time.Hour+time.Second)) {
+		t.Fatal("escrow should expire only after CancelAfter")
+	}
+	if e.CanFinish(now.Add(2 * time.Hour)) {
+		t.Fatal("expired escrow cannot finish")
+	}
+	if !e.Fulfils([]byte("secret")) || e.Fulfils([]byte("guess")) || e.Fulfils(nil) {
+		t.Fatal("fulfillment checked wrongly")
+	}
+	if !(&Escrow{}).Fulfils(nil) || (&Escrow{}).Fulfils([]byte("secret")) {
+		t.Fatal("unconditional escrow takes no fulfillment")
+	}
+}
+
+func TestEscrowRoundTrip(t *testing.T) {
+	condition := sha256.Sum256([]byte("secret"))
+	e := &Escrow{
+		Account:     "alice",
+		Sequence:    3,
+		Destination: "bob",
+		Amount:      amount.Amount{Value: amount.FromUint64(40), Currency: "USD", Issuer: "carol"},
+		FinishAfter: time.Unix(1_700_000_000, 0).UTC(),
+		CancelAfter: time.Unix(1_700_003_600, 0).UTC(),
+		Condition:   condition[:],
+	}
+	data, err := e.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Escrow
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, e) {
+		t.Fatalf("got %+v, want %+v", got, e)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package block
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// Key spaces keep the keys of different kinds of objects apart
+const (
+	spaceAccount     byte = 'a'
+	spaceTransaction byte = 't'
+	spaceParams      byte = 'p'
+	spaceOffer       byte = 'o'
+	spaceBook        byte = 'b'
+	spaceEscrow      byte = 'e'
+	spaceChannel     byte = 'c'
+)
+
+// indexKey hashes the key space and the parts identifying an object
+func indexKey(space byte, parts ...[]byte) Key {
+	h := sha256.New()
+	h.Write([]byte{space})
+	for _, p := range parts {
+		h.Write(p)
+	}
+	var k Key
+	copy(k[:], h.Sum(nil))
+	return k
+}
+
+// AccountKey returns the state tree key of an account
+func AccountKey(accountID string) Key {
+	return indexKey(spaceAccount, []byte(accountID))
+}
+
+// TransactionKey returns the transaction tree key of a serialized transaction
+func TransactionKey(blob []byte) Key {
+	return indexKey(spaceTransaction, blob)
+}
+
+// ParamsKey returns the state tree key of the network parameters
+func ParamsKey() Key {
+	return indexKey(spaceParams)
+}
+
+// OfferKey returns the state tree key of the offer an account placed with
+// the given sequence
+func OfferKey(accountID string, sequence uint64) Key {
+	var seq [8]byte
+	binary.BigEndian.PutUint64(seq[:], sequence)
+	return indexKey(spaceOffer, []byte(accountID), seq[:])
+}
+
+// EscrowKey returns the state tree key of the escrow an account created with
+// the given sequence
+func EscrowKey(accountID string, sequence uint64) Key {
+	var seq [8]byte
+	binary.BigEndian.PutUint64(seq[:], sequence)
+	return indexKey(spaceEscrow, []byte(accountID), seq[:])
+}
+
+// ChannelKey returns the state tree key of the payment channel an account
+// created with the given sequence
+func ChannelKey(accountID string, sequence uint64) Key {
+	var seq [8]byte
+	binary.BigEndian.PutUint64(seq[:], sequence)
+	return indexKey(spaceChannel, []byte(accountID), seq[:])
+}
+
+// BookKey returns the state tree key of the directory of offers paying
+// paysCurrency of paysIssuer in exchange for getsCurrency of getsIssuer at
+// quality. The key is the hash of the book with its last bytes replaced by
+// quality, so the directories of a book are next to each other in the tree
+// and ordered by quality. The parts are separated by a zero byte, which no
+// currency code or address contains.
+func BookKey(paysCurrency, paysIssuer, getsCurrency, getsIssuer string, quality []byte) Key {
+	k := indexKey(spaceBook,
+		[]byte(paysCurrency), []byte{0}, []byte(paysIssuer), []byte{0},
+		[]byte(getsCurrency), []byte{0}, []byte(getsIssuer))
+	copy(k[KeySize-len(quality):], quality)
+	return k
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package offer defines the offers of the on-ledger exchange and the order
+// books that rank them
+package offer
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// Offer is a standing order to exchange TakerGets for TakerPays. A taker of
+// the offer pays TakerPays to the owner and gets TakerGets in return.
+type Offer struct {
+	Account   string        `json:"account"`    // Owner of the offer
+	Sequence  uint64        `json:"sequence"`   // Sequence of the OfferCreate that placed it
+	TakerPays amount.Amount `json:"taker_pays"` // Amount the owner wants to receive
+	TakerGets amount.Amount `json:"taker_gets"` // Amount the owner gives
+	ExpiresAt time.Time     `json:"expires_at"` // Zero if the offer does not expire
+
+	// Quality is the quality the offer was placed at, which names the
+	// directory holding it while partial fills change its amounts
+	Quality Quality `json:"quality"`
+}
+
+// QualitySize is the size of an encoded quality
+const QualitySize = 9
+
+// Quality is the ratio TakerPays/TakerGets of an offer, encoded as the
+// exponent and mantissa of the ratio so that comparing two qualities byte by
+// byte compares the ratios. The zero Quality is below every offer's quality.
+type Quality [QualitySize]byte
+
+// QualityOf returns the quality of an offer paying pays for gets. The ratio
+// is rounded to the precision of a Value, so offers of nearly the same price
+// share a quality; ratios out of the range of a Value are clamped to it.
+func QualityOf(pays, gets amount.Value) Quality {
+	ratio, err := pays.Div(gets, amount.RoundHalfEven)
+	if err != nil {
+		ratio = amount.MaxValue
+	}
+
+	var q Quality
+	data, _ := ratio.MarshalBinary()
+	if len(data) == 0 {
+		// the smallest value: lowest exponent and mantissa
+		binary.BigEndian.PutUint64(q[1:], amount.MinMantissa)
+		return q
+	}
+	// skip the sign byte, a ratio of two amounts is never negative
+	copy(q[:], data[1:])
+	return q
+}
+
+// Better reports whether o has a better quality than other for a taker, that
+// is a lower TakerPays to TakerGets ratio. The ratios are compared exactly so
+// that every node ranks offers the same way.
+func (o *Offer) Better(other *Offer) bool {
+	return CompareQuality(o.TakerPays.Value, o.TakerGets.Value, other.TakerPays.Value, other.TakerGets.Value) < 0
+}
+
+// CompareQuality compares the ratios pays1/gets1 and pays2/gets2, returning
+// -1, 0 or +1
+func CompareQuality(pays1, gets1, pays2, gets2 amount.Value) int {
+	return amount.CompareProducts(pays1, gets2, pays2, gets1)
+}
+
+// MarshalBinary returns the canonical encoding of the offer
+func (o *Offer) MarshalBinary() ([]byte, error) {
+	pays, err := o.TakerPays.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	gets, err := o.TakerGets.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	e := codec.NewEncoder()
+	e.Uint64(codec.FieldSequence, o.Sequence)
+	e.Time(codec.FieldExpiresAt, o.ExpiresAt)
+	e.String(codec.FieldAccount, o.Account)
+	e.Object(codec.FieldTakerPays, pays)
+	e.Object(codec.FieldTakerGets, gets)
+	e.Blob(codec.FieldQuality, o.Quality[:])
+	return e.Bytes()
+}
+
+// UnmarshalBinary decodes an offer from its canonical encoding
+func (o *Offer) UnmarshalBinary(data []byte) error {
+	*o = Offer{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldSequence:
+			o.Sequence = d.Uint64()
+		case codec.FieldExpiresAt:
+			o.ExpiresAt = d.Time()
+		case codec.FieldAccount:
+			o.Account = d.String()
+		case codec.FieldTakerPays:
+			if err := o.TakerPays.UnmarshalBinary(d.Object()); err != nil {
+				return err
+			}
+		case codec.FieldTakerGets:
+			if err := o.TakerGets.UnmarshalBinary(d.Object()); err != nil {
+				return err
+			}
+		case codec.FieldQuality:
+			copy(o.Quality[:], d.Blob())
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+// Ref identifies an offer by its owner and sequence
+type Ref struct {
+	Account  string `json:"account"`
+	Sequence uint64 `json:"sequence"`
+}
+
+// Directory lists the offers of an order book placed at the same quality,
+// in the order they were placed
+type Directory struct {
+	Offers []Ref `json:"offers"`
+}
+
+// Remove deletes ref from the directory, reporting whether it was found
+func (dir *Directory) Remove(ref Ref) bool {
+	for i := range dir.Offers {
+		if dir.Offers[i] == ref {
+			dir.Offers = append(dir.Offers[:i], dir.Offers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalBinary returns the canonical encoding of the directory
+func (dir *Directory) MarshalBinary() ([]byte, error) {
+	refs := make([][]byte, len(dir.Offers))
+	for i, ref := range dir.Offers {
+		e := codec.NewEncoder()
+		e.Uint64(codec.FieldSequence, ref.Sequence)
+		e.String(codec.FieldAccount, ref.Account)
+		data, err := e.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		refs[i] = data
+	}
+
+	e := codec.NewEncoder()
+	e.Array(codec.FieldOffers, refs)
+	return e.Bytes()
+}
+
+// UnmarshalBinary decodes a directory from its canonical encoding
+func (dir *Directory) UnmarshalBinary(data []byte) error {
+	*dir = Directory{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldOffers:
+			for _, item := range d.Array() {
+				var ref Ref
+				rd := codec.NewDecoder(item)
+				for rd.Next() {
+					switch rd.Field() {
+					case codec.FieldSequence:
+						ref.Sequence = rd.Uint64()
+					case codec.FieldAccount:
+						ref.Account = rd.String()
+					default:
+						rd.Skip()
+					}
+				}
+				if err := rd.Err(); err != nil {
+					return err
+				}
+				dir.Offers = append(dir.Offers, ref)
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
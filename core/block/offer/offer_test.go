@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package offer
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+)
+
+func TestBetter(t *testing.T) {
+	a := &Offer{TakerPays: amount.Amount{Value: amount.FromUint64(1)}, TakerGets: amount.Amount{Value: amount.FromUint64(3)}}
+	b := &Offer{TakerPays: amount.Amount{Value: amount.FromUint64(2)}, TakerGets: amount.Amount{Value: amount.FromUint64(5)}}
+	if !a.Better(b) || b.Better(a) || a.Better(a) {
+		t.Fatal("1/3 should rank before 2/5")
+	}
+
+	// ratios closer than the precision of a quotient are still told apart
+	big, less := amount.FromUint64(9_999_999_999_999_999_999), amount.FromUint64(9_999_999_999_999_999_998)
+	if CompareQuality(less, big, big, less) != -1 {
+		t.Fatal("close ratios compared wrongly")
+	}
+}
+
+func TestOfferRoundTrip(t *testing.T) {
+	o := &Offer{
+		Account:   "alice",
+		Sequence:  7,
+		TakerPays: amount.Amount{Value: amount.FromUint64(100), Currency: "USD", Issuer: "bob"},
+		TakerGets: amount.Amount{Value: amount.FromUint64(250)},
+		ExpiresAt: time.Unix(1_700_000_000, 0).UTC(),
+		Quality:   QualityOf(amount.FromUint64(100), amount.FromUint64(250)),
+	}
+	data, err := o.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Offer
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, o) {
+		t.Fatalf("got %+v, want %+v", got, o)
+	}
+}
+
+func TestQualityOf(t *testing.T) {
+	tiny, _ := amount.New(1, -90)
+	// ratios in increasing order
+	ratios := [][2]amount.Value{
+		{tiny, amount.MaxValue},
+		{amount.FromUint64(1), amount.FromUint64(3)},
+		{amount.FromUint64(2), amount.FromUint64(5)},
+		{amount.FromUint64(1), amount.FromUint64(1)},
+		{amount.FromUint64(7), amount.FromUint64(2)},
+		{amount.FromUint64(1000), amount.FromUint64(1)},
+		{amount.MaxValue, tiny},
+	}
+	for i := 1; i < len(ratios); i++ {
+		a, b := QualityOf(ratios[i-1][0], ratios[i-1][1]), QualityOf(ratios[i][0], ratios[i][1])
+		if bytes.Compare(a[:], b[:]) >= 0 {
+			t.Fatalf("quality %d is not below quality %d", i-1, i)
+		}
+	}
+	if q := QualityOf(tiny, amount.MaxValue); q == (Quality{}) {
+		t.Fatal("an offer quality must be above the zero quality")
+	}
+}
+
+func TestDirectory(t *testing.T) {
+	dir := Directory{Offers: []Ref{{Account: "bob", Sequence: 1}, {Account: "carol", Sequence: 5}, {Account: "alice", Sequence: 2}}}
+	if !dir.Remove(Ref{Account: "carol", Sequence: 5}) || dir.Remove(Ref{Account: "carol", Sequence: 5}) {
+		t.Fatal("Remove should find the offer once")
+	}
+
+	data, err := dir.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Directory
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	want := []Ref{{Account: "bob", Sequence: 1}, {Account: "alice", Sequence: 2}}
+	if !reflect.DeepEqual(got.Offers, want) {
+		t.Fatalf("got %+v, want %+v", got.Offers, want)
+	}
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package block
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidProof = errors.New("shamap: invalid proof")
+	ErrProofKey     = errors.New("shamap: proof is for another key")
+)
+
+// Proof shows that a key is, or is not, present under a SHAMap root hash.
+//
+// Path lists the inner nodes met when following the key's nibbles from the
+// root. The walk ends either on an empty branch, in which case Leaf is nil, or
+// on a leaf. The key is included when that leaf holds the key itself; any
+// other leaf proves the key is absent, since a leaf sits at the shallowest
+// depth where its prefix is unique and the key could only have lived there.
+type Proof struct {
+	Key  Key         `json:"key"`
+	Path []ProofNode `json:"path"`
+	Leaf *ProofLeaf  `json:"leaf,omitempty"`
+}
+
+// ProofNode is the compact form of an inner node: a bitmap of its non-empty
+// branches and the hashes of those branches, in branch order, leaving out the
+// branch the path follows.
+type ProofNode struct {
+	Branches uint16   `json:"branches"`
+	Hashes   [][]byte `json:"hashes"`
+}
+
+// ProofLeaf is the leaf found at the end of a proof path
+type ProofLeaf struct {
+	Key  Key    `json:"key"`
+	Data []byte `json:"data"`
+}
+
+// Included reports whether the proof claims the key is present. The claim
+// only holds once VerifyProof has accepted the proof.
+func (p *Proof) Included() bool {
+	return p.Leaf != nil && p.Leaf.Key == p.Key
+}
+
+// Prove builds an inclusion or non-inclusion proof for key
+func (m *SHAMap) Prove(key Key) *Proof {
+	proof := &Proof{Key: key}
+	if m.root == nil {
+		return proof
+	}
+
+	n := m.root
+	for depth := 0; ; depth++ {
+		branch := key.nibble(depth)
+		pn := ProofNode{}
+		for i, c := range n.children {
+			if c == nil {
+				continue
+			}
+			pn.Branches |= 1 << i
+			if i != branch {
+				pn.Hashes = append(pn.Hashes, c.hash())
+			}
+		}
+		proof.Path = append(proof.Path, pn)
+
+		switch c := n.children[branch].(type) {
+		case *innerNode:
+			n = c
+		case *leafNode:
+			proof.Leaf = &ProofLeaf{Key: c.key, Data: c.data}
+			return proof
+		default:
+			return proof
+		}
+	}
+}
+
+// VerifyProof checks the proof against a trusted root hash. It returns whether
+// the key is present; when it is, proof.Leaf.Data is the authenticated data.
+func VerifyProof(root []byte, proof *Proof) (bool, error) {
+	if proof == nil {
+		return false, ErrInvalidProof
+	}
+
+	if len(proof.Path) == 0 {
+		// only an empty map has no inner node on the path
+		if proof.Leaf != nil || !bytes.Equal(root, zeroHash) {
+			return false, ErrInvalidProof
+		}
+		return false, nil
+	}
+	if len(proof.Path) > maxDepth {
+		return false, ErrInvalidProof
+	}
+
+	var hash []byte
+	if proof.Leaf != nil {
+		depth := len(proof.Path) - 1
+		for d := 0; d <= depth; d++ {
+			if proof.Leaf.Key.nibble(d) != proof.Key.nibble(d) {
+				return false, ErrInvalidProof
+			}
+		}
+		hash = hashLeaf(proof.Leaf.Key, proof.Leaf.Data)
+	}
+
+	for depth := len(proof.Path) - 1; depth >= 0; depth-- {
+		pn := proof.Path[depth]
+		branch := proof.Key.nibble(depth)
+
+		// the followed branch is empty only at the end of a non-inclusion path
+		if (pn.Branches&(1<<branch) != 0) != (hash != nil) {
+			return false, ErrInvalidProof
+		}
+
+		var children [branchFactor][]byte
+		next := 0
+		for i := 0; i < branchFactor; i++ {
+			if i == branch {
+				children[i] = hash
+				continue
+			}
+			if pn.Branches&(1<<i) == 0 {
+				continue
+			}
+			if next >= len(pn.Hashes) || len(pn.Hashes[next]) != len(zeroHash) {
+				return false, ErrInvalidProof
+			}
+			children[i] = pn.Hashes[next]
+			next++
+		}
+		if next != len(pn.Hashes) {
+			return false, ErrInvalidProof
+		}
+		hash = hashInner(children)
+	}
+
+	if !bytes.Equal(hash, root) {
+		return false, fmt.Errorf("%w: %v", ErrInvalidProof, ErrRootHash)
+	}
+	return proof.Included(), nil
+}
+
+// ProveAccount builds a proof for an account against the block's StateHash
+func (b *Block) ProveAccount(accountID string) *Proof {
+	return b.Accounts.Prove(AccountKey(accountID))
+}
+
+// ProveTransaction builds a proof for a transaction against the block's TxHash
+func (b *Block) ProveTransaction(key Key) *Proof {
+	return b.Transactions.Prove(key)
+}
+
+// VerifyAccountProof checks a proof for an account against a trusted header.
+// It returns the serialized account when it is present in the ledger.
+func VerifyAccountProof(header *BlockHeader, accountID string, proof *Proof) ([]byte, bool, error) {
+	return verifyKeyProof(header.StateHash, AccountKey(accountID), proof)
+}
+
+// VerifyTransactionProof checks a proof for a transaction against a trusted header.
+// It returns the serialized transaction when it is present in the ledger.
+func VerifyTransactionProof(header *BlockHeader, key Key, proof *Proof) ([]byte, bool, error) {
+	return verifyKeyProof(header.TxHash, key, proof)
+}
+
+func verifyKeyProof(root []byte, key Key, proof *Proof) ([]byte, bool, error) {
+	if proof == nil || proof.Key != key {
+		return nil, false, ErrProofKey
+	}
+	included, err := VerifyProof(root, proof)
+	if err != nil || !included {
+		return nil, false, err
+	}
+	return proof.Leaf.Data, true, nil
+}
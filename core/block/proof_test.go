@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package block
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestProofInclusion(t *testing.T) {
+	var m SHAMap
+	for i := 0; i < 200; i++ {
+		m.Set(testKey(i), []byte{byte(i)})
+	}
+	root := m.RootHash()
+
+	for i := 0; i < 200; i += 7 {
+		proof := m.Prove(testKey(i))
+		included, err := VerifyProof(root, proof)
+		if err != nil || !included {
+			t.Fatalf("key %d: included %v, err %v", i, included, err)
+		}
+		if proof.Leaf.Data[0] != byte(i) {
+			t.Fatalf("key %d: wrong data %v", i, proof.Leaf.Data)
+		}
+	}
+}
+
+func TestProofNonInclusion(t *testing.T) {
+	var m SHAMap
+	for i := 0; i < 200; i++ {
+		m.Set(testKey(i), []byte{byte(i)})
+	}
+	root := m.RootHash()
+
+	for i := 200; i < 300; i++ {
+		included, err := VerifyProof(root, m.Prove(testKey(i)))
+		if err != nil || included {
+			t.Fatalf("key %d: included %v, err %v", i, included, err)
+		}
+	}
+
+	var empty SHAMap
+	included, err := VerifyProof(empty.RootHash(), empty.Prove(testKey(1)))
+	if err != nil || included {
+		t.Fatalf("empty map: included %v, err %v", included, err)
+	}
+}
+
+func TestProofTampered(t *testing.T) {
+	var m SHAMap
+	for i := 0; i < 50; i++ {
+		m.Set(testKey(i), []byte{byte(i)})
+	}
+	root := m.RootHash()
+
+	proof := m.Prove(testKey(3))
+	proof.Leaf.Data = []byte{0xff}
+	if _, err := VerifyProof(root, proof); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("tampered data: got %v", err)
+	}
+
+	// claiming the key is absent by dropping the leaf must fail
+	proof = m.Prove(testKey(3))
+	proof.Leaf = nil
+	if _, err := VerifyProof(root, proof); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("dropped leaf: got %v", err)
+	}
+
+	proof = m.Prove(testKey(3))
+	proof.Key = testKey(4)
+	if _, err := VerifyProof(root, proof); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("swapped key: got %v", err)
+	}
+}
+
+func TestAccountProof(t *testing.T) {
+	b := NewBlock(1, nil, 100)
+	b.Accounts.Set(AccountKey("alice"), []byte("alice"))
+	b.Accounts.Set(AccountKey("bob"), []byte("bob"))
+	b.UpdateRoots()
+
+	data, err := json.Marshal(b.ProveAccount("alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var proof Proof
+	if err := json.Unmarshal(data, &proof); err != nil {
+		t.Fatal(err)
+	}
+
+	got, included, err := VerifyAccountProof(&b.Header, "alice", &proof)
+	if err != nil || !included || string(got) != "alice" {
+		t.Fatalf("got %q %v %v", got, included, err)
+	}
+	if _, _, err := VerifyAccountProof(&b.Header, "bob", &proof); err != ErrProofKey {
+		t.Fatalf("proof for another account: got %v", err)
+	}
+	_, included, err = VerifyAccountProof(&b.Header, "carol", b.ProveAccount("carol"))
+	if err != nil || included {
+		t.Fatalf("missing account: included %v, err %v", included, err)
+	}
+}
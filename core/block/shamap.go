@@ -0,0 +1,480 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package block
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// KeySize is the size in bytes of a SHAMap key
+const KeySize = 32
+
+// branchFactor is the number of children of an inner node (one per nibble)
+const branchFactor = 16
+
+var (
+	// hashPrefixLeaf and hashPrefixInner separate the hash domains of leaf and
+	// inner nodes so that a leaf can never be mistaken for an inner node
+	hashPrefixLeaf  = []byte{'M', 'L', 'N', 0x00}
+	hashPrefixInner = []byte{'M', 'I', 'N', 0x00}
+
+	// zeroHash is the hash of an empty branch and of an empty map
+	zeroHash = make([]byte, sha256.Size)
+)
+
+var (
+	ErrKeyExists   = errors.New("shamap: key already exists")
+	ErrKeyNotFound = errors.New("shamap: key not found")
+	ErrRootHash    = errors.New("shamap: root hash mismatch")
+)
+
+// maxDepth is the number of nibbles in a key
+const maxDepth = 2 * KeySize
+
+// Key identifies an item stored in a SHAMap
+type Key [KeySize]byte
+
+// String returns the hex representation of the key
+func (k Key) String() string {
+	return hex.EncodeToString(k[:])
+}
+
+// MarshalText encodes the key as hex
+func (k Key) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText decodes a hex encoded key
+func (k *Key) UnmarshalText(text []byte) error {
+	parsed, err := ParseKey(string(text))
+	if err != nil {
+		return err
+	}
+	*k = parsed
+	return nil
+}
+
+// nibble returns the branch taken by the key at the given depth
+func (k Key) nibble(depth int) int {
+	b := k[depth/2]
+	if depth%2 == 0 {
+		return int(b >> 4)
+	}
+	return int(b & 0x0f)
+}
+
+// ParseKey decodes a hex encoded key
+func ParseKey(s string) (Key, error) {
+	var k Key
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return k, err
+	}
+	if len(b) != KeySize {
+		return k, fmt.Errorf("shamap: invalid key length %d", len(b))
+	}
+	copy(k[:], b)
+	return k, nil
+}
+
+// SHAMap is an authenticated radix-16 Merkle trie keyed by 256-bit object IDs.
+//
+// Leaves are stored at the shallowest depth where their key prefix is unique,
+// so the shape of the tree (and therefore the root hash) depends only on the
+// set of items it contains and not on the order they were inserted in. Nodes
+// are immutable: every update copies the path from the root to the modified
+// leaf, which makes Copy cheap and lets snapshots share unchanged subtrees.
+//
+// The zero value is an empty map ready to use.
+type SHAMap struct {
+	root *innerNode
+	size int
+}
+
+// shaMapNode is either an *innerNode or a *leafNode
+type shaMapNode interface {
+	hash() []byte
+}
+
+type innerNode struct {
+	children [branchFactor]shaMapNode
+	nodeHash []byte
+}
+
+type leafNode struct {
+	key      Key
+	data     []byte
+	nodeHash []byte
+}
+
+func newLeaf(key Key, data []byte) *leafNode {
+	l := &leafNode{key: key, data: append([]byte(nil), data...)}
+	l.nodeHash = hashLeaf(key, l.data)
+	return l
+}
+
+func newInner(children [branchFactor]shaMapNode) *innerNode {
+	n := &innerNode{children: children}
+	var hashes [branchFactor][]byte
+	for i, c := range children {
+		if c != nil {
+			hashes[i] = c.hash()
+		}
+	}
+	n.nodeHash = hashInner(hashes)
+	return n
+}
+
+func hashLeaf(key Key, data []byte) []byte {
+	h := sha256.New()
+	h.Write(hashPrefixLeaf)
+	h.Write(key[:])
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// hashInner hashes the child hashes of an inner node, nil standing for an empty branch
+func hashInner(children [branchFactor][]byte) []byte {
+	h := sha256.New()
+	h.Write(hashPrefixInner)
+	for _, c := range children {
+		if c == nil {
+			h.Write(zeroHash)
+		} else {
+			h.Write(c)
+		}
+	}
+	return h.Sum(nil)
+}
+
+func (n *innerNode) hash() []byte { return n.nodeHash }
+func (l *leafNode) hash() []byte  { return l.nodeHash }
+
+// NewSHAMap returns an empty map
+func NewSHAMap() *SHAMap {
+	return &SHAMap{}
+}
+
+// Len returns the number of items in the map
+func (m *SHAMap) Len() int {
+	return m.size
+}
+
+// RootHash returns the Merkle root of the map. An empty map hashes to 32 zero bytes.
+func (m *SHAMap) RootHash() []byte {
+	if m.root == nil {
+		return append([]byte(nil), zeroHash...)
+	}
+	return append([]byte(nil), m.root.nodeHash...)
+}
+
+// Copy returns an independent snapshot of the map. Later changes to either
+// map are not visible in the other.
+func (m *SHAMap) Copy() *SHAMap {
+	return &SHAMap{root: m.root, size: m.size}
+}
+
+// Get returns the data stored under key. The returned slice must not be modified.
+func (m *SHAMap) Get(key Key) ([]byte, bool) {
+	if m.root == nil {
+		return nil, false
+	}
+	n := m.root
+	for depth := 0; ; depth++ {
+		switch c := n.children[key.nibble(depth)].(type) {
+		case *innerNode:
+			n = c
+		case *leafNode:
+			if c.key == key {
+				return c.data, true
+			}
+			return nil, false
+		default:
+			return nil, false
+		}
+	}
+}
+
+// Has reports whether key is present in the map
+func (m *SHAMap) Has(key Key) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Insert adds a new item. It fails with ErrKeyExists if the key is already present.
+func (m *SHAMap) Insert(key Key, data []byte) error {
+	if m.Has(key) {
+		return ErrKeyExists
+	}
+	m.Set(key, data)
+	return nil
+}
+
+// Update replaces the data of an existing item. It fails with ErrKeyNotFound
+// if the key is not present.
+func (m *SHAMap) Update(key Key, data []byte) error {
+	if !m.Has(key) {
+		return ErrKeyNotFound
+	}
+	m.Set(key, data)
+	return nil
+}
+
+// Set inserts the item or replaces its data if the key is already present
+func (m *SHAMap) Set(key Key, data []byte) {
+	root := m.root
+	if root == nil {
+		root = &innerNode{}
+	}
+	var replaced bool
+	m.root, replaced = setLeaf(root, 0, newLeaf(key, data))
+	if !replaced {
+		m.size++
+	}
+}
+
+// Delete removes an item. It fails with ErrKeyNotFound if the key is not present.
+func (m *SHAMap) Delete(key Key) error {
+	if m.root == nil {
+		return ErrKeyNotFound
+	}
+	n, err := removeLeaf(m.root, 0, key)
+	if err != nil {
+		return err
+	}
+	m.size--
+
+	switch c := n.(type) {
+	case nil:
+		m.root = nil
+	case *innerNode:
+		m.root = c
+	}
+	return nil
+}
+
+// Walk calls fn for every item in ascending key order. Iteration stops at the
+// first error returned by fn, which is then returned by Walk.
+func (m *SHAMap) Walk(fn func(key Key, data []byte) error) error {
+	if m.root == nil {
+		return nil
+	}
+	return walk(m.root, fn)
+}
+
+func walk(n shaMapNode, fn func(key Key, data []byte) error) error {
+	switch c := n.(type) {
+	case *leafNode:
+		return fn(c.key, c.data)
+	case *innerNode:
+		for _, child := range c.children {
+			if child == nil {
+				continue
+			}
+			if err := walk(child, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Next returns the item with the smallest key greater than key, and false
+// when there is none. Keys are ordered as in Walk, so the items sharing a key
+// prefix can be visited in order without walking the whole map.
+func (m *SHAMap) Next(key Key) (Key, []byte, bool) {
+	if m.root == nil {
+		return Key{}, nil, false
+	}
+	l := next(m.root, 0, key)
+	if l == nil {
+		return Key{}, nil, false
+	}
+	return l.key, l.data, true
+}
+
+// next returns the leaf below n with the smallest key greater than key. Every
+// key below n shares its first depth nibbles with key.
+func next(n *innerNode, depth int, key Key) *leafNode {
+	branch := key.nibble(depth)
+	for i := branch; i < branchFactor; i++ {
+		var l *leafNode
+		switch c := n.children[i].(type) {
+		case *leafNode:
+			if i > branch || bytes.Compare(c.key[:], key[:]) > 0 {
+				l = c
+			}
+		case *innerNode:
+			if i > branch {
+				l = first(c)
+			} else {
+				l = next(c, depth+1, key)
+			}
+		}
+		if l != nil {
+			return l
+		}
+	}
+	return nil
+}
+
+// first returns the leaf below n with the smallest key
+func first(n *innerNode) *leafNode {
+	for _, child := range n.children {
+		switch c := child.(type) {
+		case *leafNode:
+			return c
+		case *innerNode:
+			if l := first(c); l != nil {
+				return l
+			}
+		}
+	}
+	return nil
+}
+
+// setLeaf returns a copy of n with l stored below it, and whether an item with
+// the same key was replaced
+func setLeaf(n *innerNode, depth int, l *leafNode) (*innerNode, bool) {
+	children := n.children
+	branch := l.key.nibble(depth)
+	replaced := false
+
+	switch c := children[branch].(type) {
+	case nil:
+		children[branch] = l
+	case *leafNode:
+		if c.key == l.key {
+			children[branch] = l
+			replaced = true
+		} else {
+			children[branch] = splitLeaves(c, l, depth+1)
+		}
+	case *innerNode:
+		children[branch], replaced = setLeaf(c, depth+1, l)
+	}
+
+	return newInner(children), replaced
+}
+
+// splitLeaves builds the subtree holding two leaves whose keys share a prefix
+// up to depth
+func splitLeaves(a, b *leafNode, depth int) *innerNode {
+	var children [branchFactor]shaMapNode
+	na, nb := a.key.nibble(depth), b.key.nibble(depth)
+	if na == nb {
+		children[na] = splitLeaves(a, b, depth+1)
+	} else {
+		children[na] = a
+		children[nb] = b
+	}
+	return newInner(children)
+}
+
+// removeLeaf returns the node replacing n once key is removed below it. Inner
+// nodes left with no children disappear, and below the root an inner node
+// left with a single leaf is replaced by that leaf, keeping the tree canonical.
+func removeLeaf(n *innerNode, depth int, key Key) (shaMapNode, error) {
+	children := n.children
+	branch := key.nibble(depth)
+
+	switch c := children[branch].(type) {
+	case nil:
+		return nil, ErrKeyNotFound
+	case *leafNode:
+		if c.key != key {
+			return nil, ErrKeyNotFound
+		}
+		children[branch] = nil
+	case *innerNode:
+		child, err := removeLeaf(c, depth+1, key)
+		if err != nil {
+			return nil, err
+		}
+		children[branch] = child
+	}
+
+	count := 0
+	var last shaMapNode
+	for _, c := range children {
+		if c != nil {
+			count++
+			last = c
+		}
+	}
+
+	if count == 0 {
+		return nil, nil
+	}
+	if leaf, ok := last.(*leafNode); ok && count == 1 && depth > 0 {
+		return leaf, nil
+	}
+	return newInner(children), nil
+}
+
+// shaMapItem is the JSON representation of an item
+type shaMapItem struct {
+	Key  string `json:"key"`
+	Data []byte `json:"data"`
+}
+
+type shaMapJSON struct {
+	RootHash []byte       `json:"root_hash"`
+	Items    []shaMapItem `json:"items"`
+}
+
+// MarshalJSON encodes the map as its root hash and the ordered list of items
+func (m SHAMap) MarshalJSON() ([]byte, error) {
+	out := shaMapJSON{RootHash: m.RootHash(), Items: make([]shaMapItem, 0, m.size)}
+	_ = m.Walk(func(key Key, data []byte) error {
+		out.Items = append(out.Items, shaMapItem{Key: key.String(), Data: data})
+		return nil
+	})
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON rebuilds the map from its items and checks the root hash
+func (m *SHAMap) UnmarshalJSON(data []byte) error {
+	var in shaMapJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	var rebuilt SHAMap
+	for _, item := range in.Items {
+		key, err := ParseKey(item.Key)
+		if err != nil {
+			return err
+		}
+		if err := rebuilt.Insert(key, item.Data); err != nil {
+			return err
+		}
+	}
+
+	if in.RootHash != nil && !bytes.Equal(in.RootHash, rebuilt.RootHash()) {
+		return ErrRootHash
+	}
+
+	*m = rebuilt
+	return nil
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package block
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Node blobs start with a type byte followed by the node content: a leaf
+// holds its key and data, an inner node the 16 hashes of its branches.
+const (
+	nodeTypeLeaf  byte = 'L'
+	nodeTypeInner byte = 'I'
+)
+
+var ErrInvalidNode = errors.New("shamap: invalid node")
+
+// NodeFetcher returns the serialized node with the given hash
+type NodeFetcher func(hash []byte) ([]byte, error)
+
+func (l *leafNode) serialize() []byte {
+	blob := make([]byte, 0, 1+KeySize+len(l.data))
+	blob = append(blob, nodeTypeLeaf)
+	blob = append(blob, l.key[:]...)
+	return append(blob, l.data...)
+}
+
+func (n *innerNode) serialize() []byte {
+	blob := make([]byte, 0, 1+branchFactor*len(zeroHash))
+	blob = append(blob, nodeTypeInner)
+	for _, c := range n.children {
+		if c == nil {
+			blob = append(blob, zeroHash...)
+		} else {
+			blob = append(blob, c.hash()...)
+		}
+	}
+	return blob
+}
+
+// WalkNodes calls fn with the hash and serialized form of every node, parents
+// before children. When skip reports true for an inner node hash, the node
+// and its whole subtree are left out, which lets a store write only the nodes
+// it does not already hold. skip may be nil.
+func (m *SHAMap) WalkNodes(skip func(hash []byte) bool, fn func(hash, blob []byte) error) error {
+	if m.root == nil {
+		return nil
+	}
+	return walkNodes(m.root, skip, fn)
+}
+
+func walkNodes(n shaMapNode, skip func(hash []byte) bool, fn func(hash, blob []byte) error) error {
+	switch c := n.(type) {
+	case *leafNode:
+		return fn(c.nodeHash, c.serialize())
+	case *innerNode:
+		if skip != nil && skip(c.nodeHash) {
+			return nil
+		}
+		if err := fn(c.nodeHash, c.serialize()); err != nil {
+			return err
+		}
+		for _, child := range c.children {
+			if child == nil {
+				continue
+			}
+			if err := walkNodes(child, skip, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// NodeHash returns the hash of a serialized node without loading its
+// children, so a node received from a peer can be checked against the hash
+// it was requested by.
+func NodeHash(blob []byte) ([]byte, error) {
+	if len(blob) == 0 {
+		return nil, ErrInvalidNode
+	}
+	switch blob[0] {
+	case nodeTypeLeaf:
+		if len(blob) < 1+KeySize {
+			return nil, ErrInvalidNode
+		}
+		var key Key
+		copy(key[:], blob[1:])
+		return hashLeaf(key, blob[1+KeySize:]), nil
+	case nodeTypeInner:
+		if len(blob) != 1+branchFactor*len(zeroHash) {
+			return nil, ErrInvalidNode
+		}
+		var children [branchFactor][]byte
+		for i := range children {
+			child := blob[1+i*len(zeroHash) : 1+(i+1)*len(zeroHash)]
+			if !bytes.Equal(child, zeroHash) {
+				children[i] = child
+			}
+		}
+		return hashInner(children), nil
+	}
+	return nil, ErrInvalidNode
+}
+
+// ChildHashes returns the hashes of the non empty branches of a serialized
+// inner node, and nothing for a leaf, so a tree can be fetched level by level
+// before it is loaded.
+func ChildHashes(blob []byte) ([][]byte, error) {
+	if len(blob) == 0 {
+		return nil, ErrInvalidNode
+	}
+	switch blob[0] {
+	case nodeTypeLeaf:
+		return nil, nil
+	case nodeTypeInner:
+		if len(blob) != 1+branchFactor*len(zeroHash) {
+			return nil, ErrInvalidNode
+		}
+		var children [][]byte
+		for i := 0; i < branchFactor; i++ {
+			child := blob[1+i*len(zeroHash) : 1+(i+1)*len(zeroHash)]
+			if !bytes.Equal(child, zeroHash) {
+				children = append(children, child)
+			}
+		}
+		return children, nil
+	}
+	return nil, ErrInvalidNode
+}
+
+// LoadSHAMap rebuilds the map with the given root hash from serialized nodes.
+// Every node is checked against the hash it was requested by, so the fetcher
+// may be an untrusted source such as a peer.
+func LoadSHAMap(root []byte, fetch NodeFetcher) (*SHAMap, error) {
+	m := &SHAMap{}
+	if len(root) == 0 || bytes.Equal(root, zeroHash) {
+		return m, nil
+	}
+
+	n, err := loadNode(root, nil, fetch, &m.size)
+	if err != nil {
+		return nil, err
+	}
+	inner, ok := n.(*innerNode)
+	if !ok {
+		return nil, fmt.Errorf("%w: root is not an inner node", ErrInvalidNode)
+	}
+	m.root = inner
+	return m, nil
+}
+
+// loadNode loads the subtree at the given path, a list of nibbles from the root
+func loadNode(hash []byte, path []int, fetch NodeFetcher, size *int) (shaMapNode, error) {
+	blob, err := fetch(hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(blob) == 0 {
+		return nil, ErrInvalidNode
+	}
+
+	var n shaMapNode
+	switch blob[0] {
+	case nodeTypeLeaf:
+		if len(blob) < 1+KeySize {
+			return nil, ErrInvalidNode
+		}
+		var key Key
+		copy(key[:], blob[1:])
+		for depth, branch := range path {
+			if key.nibble(depth) != branch {
+				return nil, fmt.Errorf("%w: leaf %v out of place", ErrInvalidNode, key)
+			}
+		}
+		n = newLeaf(key, blob[1+KeySize:])
+		*size++
+	case nodeTypeInner:
+		if len(blob) != 1+branchFactor*len(zeroHash) || len(path) >= maxDepth {
+			return nil, ErrInvalidNode
+		}
+		var children [branchFactor]shaMapNode
+		for i := range children {
+			child := blob[1+i*len(zeroHash) : 1+(i+1)*len(zeroHash)]
+			if bytes.Equal(child, zeroHash) {
+				continue
+			}
+			if children[i], err = loadNode(child, append(path[:len(path):len(path)], i), fetch, size); err != nil {
+				return nil, err
+			}
+		}
+		n = newInner(children)
+	default:
+		return nil, ErrInvalidNode
+	}
+
+	if !bytes.Equal(n.hash(), hash) {
+		return nil, fmt.Errorf("%w: hash mismatch for node %x", ErrInvalidNode, hash)
+	}
+	return n, nil
+}
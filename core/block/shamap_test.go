@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package block
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"testing"
+)
+
+func testKey(i int) Key {
+	return indexKey('x', []byte{byte(i >> 8), byte(i)})
+}
+
+func TestSHAMapOrderIndependent(t *testing.T) {
+	const n = 500
+	var a, b SHAMap
+	for i := 0; i < n; i++ {
+		if err := a.Insert(testKey(i), []byte{byte(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, i := range rand.New(rand.NewSource(1)).Perm(n) {
+		b.Set(testKey(i), []byte{byte(i)})
+	}
+	if !bytes.Equal(a.RootHash(), b.RootHash()) {
+		t.Fatal("root hash depends on insertion order")
+	}
+	if a.Len() != n || b.Len() != n {
+		t.Fatalf("got len %d/%d, want %d", a.Len(), b.Len(), n)
+	}
+}
+
+func TestSHAMapInsertUpdateDelete(t *testing.T) {
+	var m SHAMap
+	empty := m.RootHash()
+	if !bytes.Equal(empty, zeroHash) {
+		t.Fatal("empty map must hash to zero")
+	}
+
+	if err := m.Update(testKey(1), []byte("a")); err != ErrKeyNotFound {
+		t.Fatalf("update missing key: got %v", err)
+	}
+	if err := m.Insert(testKey(1), []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Insert(testKey(1), []byte("b")); err != ErrKeyExists {
+		t.Fatalf("insert existing key: got %v", err)
+	}
+	if err := m.Insert(testKey(2), []byte("c")); err != nil {
+		t.Fatal(err)
+	}
+	withTwo := m.RootHash()
+
+	if err := m.Update(testKey(1), []byte("b")); err != nil {
+		t.Fatal(err)
+	}
+	if data, ok := m.Get(testKey(1)); !ok || string(data) != "b" {
+		t.Fatalf("got %q %v, want b", data, ok)
+	}
+	if bytes.Equal(withTwo, m.RootHash()) {
+		t.Fatal("root hash did not change on update")
+	}
+
+	var single SHAMap
+	single.Set(testKey(1), []byte("b"))
+	if err := m.Delete(testKey(2)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(single.RootHash(), m.RootHash()) {
+		t.Fatal("delete did not restore canonical shape")
+	}
+	if err := m.Delete(testKey(2)); err != ErrKeyNotFound {
+		t.Fatalf("delete missing key: got %v", err)
+	}
+	if err := m.Delete(testKey(1)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(empty, m.RootHash()) || m.Len() != 0 {
+		t.Fatal("map not empty after deleting every item")
+	}
+}
+
+func TestSHAMapDeleteCanonical(t *testing.T) {
+	const n = 300
+	var full, half SHAMap
+	for i := 0; i < n; i++ {
+		full.Set(testKey(i), []byte{byte(i)})
+		if i%2 == 0 {
+			half.Set(testKey(i), []byte{byte(i)})
+		}
+	}
+	for i := 1; i < n; i += 2 {
+		if err := full.Delete(testKey(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(full.RootHash(), half.RootHash()) {
+		t.Fatal("root hash after deletes differs from fresh map")
+	}
+}
+
+func TestSHAMapWalkOrdered(t *testing.T) {
+	var m SHAMap
+	for i := 0; i < 100; i++ {
+		m.Set(testKey(i), nil)
+	}
+	var prev *Key
+	count := 0
+	err := m.Walk(func(key Key, _ []byte) error {
+		if prev != nil && bytes.Compare(prev[:], key[:]) >= 0 {
+			t.Fatalf("keys out of order: %v then %v", prev, key)
+		}
+		k := key
+		prev = &k
+		count++
+		return nil
+	})
+	if err != nil || count != 100 {
+		t.Fatalf("walk visited %d items, err %v", count, err)
+	}
+}
+
+func TestSHAMapNext(t *testing.T) {
+	var m SHAMap
+	var keys []Key
+	for i := 0; i < 100; i++ {
+		m.Set(testKey(i), nil)
+	}
+	_ = m.Walk(func(key Key, _ []byte) error {
+		keys = append(keys, key)
+		return nil
+	})
+
+	// from the zero key, Next visits the items in the order of Walk
+	var key Key
+	for i := 0; ; i++ {
+		next, _, ok := m.Next(key)
+		if !ok {
+			if i != len(keys) {
+				t.Fatalf("Next stopped after %d items, want %d", i, len(keys))
+			}
+			break
+		}
+		if next != keys[i] {
+			t.Fatalf("item %d: got %v, want %v", i, next, keys[i])
+		}
+		key = next
+	}
+
+	// a key that is not in the map is followed by the next greater one
+	missing := keys[10]
+	missing[KeySize-1]++
+	if next, _, ok := m.Next(missing); !ok || next != keys[11] {
+		t.Fatalf("got %v, want %v", next, keys[11])
+	}
+}
+
+func TestSHAMapCopy(t *testing.T) {
+	var m SHAMap
+	m.Set(testKey(1), []byte("a"))
+	snap := m.Copy()
+	before := snap.RootHash()
+
+	m.Set(testKey(2), []byte("b"))
+	if err := m.Delete(testKey(1)); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(before, snap.RootHash()) || !snap.Has(testKey(1)) || snap.Has(testKey(2)) {
+		t.Fatal("snapshot changed with the original map")
+	}
+}
+
+func TestSHAMapJSON(t *testing.T) {
+	var m SHAMap
+	for i := 0; i < 20; i++ {
+		m.Set(testKey(i), []byte{byte(i), 1, 2})
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got SHAMap
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.RootHash(), m.RootHash()) || got.Len() != m.Len() {
+		t.Fatal("JSON round trip changed the map")
+	}
+}
+
+func TestNodeHash(t *testing.T) {
+	var m SHAMap
+	for i := 0; i < 50; i++ {
+		m.Set(testKey(i), []byte{byte(i)})
+	}
+	nodes := 0
+	err := m.WalkNodes(nil, func(hash, blob []byte) error {
+		nodes++
+		got, err := NodeHash(blob)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(got, hash) {
+			t.Errorf("node hash %x, want %x", got, hash)
+		}
+		return nil
+	})
+	if err != nil || nodes == 0 {
+		t.Fatalf("walked %d nodes: %v", nodes, err)
+	}
+	if _, err := NodeHash([]byte{nodeTypeInner, 1}); err == nil {
+		t.Fatal("truncated inner node accepted")
+	}
+}
+
+func TestChildHashes(t *testing.T) {
+	var m SHAMap
+	for i := 0; i < 50; i++ {
+		m.Set(testKey(i), []byte{byte(i)})
+	}
+
+	// every node but the root is listed once by its parent
+	listed := make(map[string]bool)
+	nodes := 0
+	err := m.WalkNodes(nil, func(hash, blob []byte) error {
+		nodes++
+		children, err := ChildHashes(blob)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			listed[string(child)] = true
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed) != nodes-1 || listed[string(m.RootHash())] {
+		t.Fatalf("%d children listed for %d nodes", len(listed), nodes)
+	}
+	if _, err := ChildHashes([]byte{nodeTypeInner, 1}); err == nil {
+		t.Fatal("truncated inner node accepted")
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package codec implements the canonical binary encoding of transactions and
+// ledger objects.
+//
+// An encoded object is a list of fields. Each field starts with a two byte
+// header, its type code then its field code, followed by the value. Fields
+// are always written in ascending (type, field) order and zero values are
+// left out, so a given object has exactly one encoding. Hashes and
+// signatures are computed over this encoding.
+package codec
+
+import "fmt"
+
+// TypeCode identifies how a field value is encoded
+type TypeCode uint8
+
+const (
+	TypeUint32 TypeCode = iota + 1 // 4 bytes, big endian
+	TypeUint64                     // 8 bytes, big endian
+	TypeInt64                      // 8 bytes, big endian two's complement
+	TypeBool                       // 1 byte, always 1 since false is left out
+	TypeTime                       // 8 bytes seconds and 4 bytes nanoseconds since the Unix epoch, UTC
+	TypeBlob                       // uvarint length and raw bytes
+	TypeString                     // uvarint length and UTF-8 bytes
+	TypeObject                     // uvarint length and a nested encoded object
+	TypeArray                      // uvarint count, then each item as uvarint length and bytes
+)
+
+var typeNames = map[TypeCode]string{
+	TypeUint32: "Uint32",
+	TypeUint64: "Uint64",
+	TypeInt64:  "Int64",
+	TypeBool:   "Bool",
+	TypeTime:   "Time",
+	TypeBlob:   "Blob",
+	TypeString: "String",
+	TypeObject: "Object",
+	TypeArray:  "Array",
+}
+
+// String returns the name of the type code
+func (t TypeCode) String() string {
+	if name, exists := typeNames[t]; exists {
+		return name
+	}
+	return fmt.Sprintf("Unknown(%d)", uint8(t))
+}
+
+// Field identifies a field by its type code and field code
+type Field uint16
+
+func newField(t TypeCode, code uint8) Field {
+	return Field(uint16(t)<<8 | uint16(code))
+}
+
+// Type returns the type code of the field
+func (f Field) Type() TypeCode {
+	return TypeCode(f >> 8)
+}
+
+// Code returns the field code, unique among the fields of the same type
+func (f Field) Code() uint8 {
+	return uint8(f)
+}
+
+// String returns the name of the field
+func (f Field) String() string {
+	if name, exists := fieldNames[f]; exists {
+		return name
+	}
+	return fmt.Sprintf("%v(%d)", f.Type(), f.Code())
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package codec
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func encodeSample(t *testing.T, order []int) []byte {
+	t.Helper()
+
+	closeTime := time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)
+	writers := []func(e *Encoder){
+		func(e *Encoder) { e.Uint32(FieldFlags, 7) },
+		func(e *Encoder) { e.Uint64(FieldBalance, 1000) },
+		func(e *Encoder) { e.Int64(FieldLineBalance, -25) },
+		func(e *Encoder) { e.Bool(FieldIsVerified, true) },
+		func(e *Encoder) { e.Time(FieldCloseTime, closeTime) },
+		func(e *Encoder) { e.Blob(FieldHash, []byte{1, 2, 3}) },
+		func(e *Encoder) { e.String(FieldAccount, "alice") },
+		func(e *Encoder) { e.StringArray(FieldUNL, []string{"n1", "n2"}) },
+	}
+
+	e := NewEncoder()
+	for _, i := range order {
+		writers[i](e)
+	}
+	data, err := e.Bytes()
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	return data
+}
+
+func TestEncodingIsCanonical(t *testing.T) {
+	a := encodeSample(t, []int{0, 1, 2, 3, 4, 5, 6, 7})
+	b := encodeSample(t, []int{7, 3, 5, 0, 6, 1, 4, 2})
+	if !bytes.Equal(a, b) {
+		t.Fatal("encoding depends on the order fields are added")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	data := encodeSample(t, []int{0, 1, 2, 3, 4, 5, 6, 7})
+
+	var (
+		flags     uint32
+		balance   uint64
+		line      int64
+		verified  bool
+		closeTime time.Time
+		hash      []byte
+		account   string
+		unl       []string
+	)
+	d := NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case FieldFlags:
+			flags = d.Uint32()
+		case FieldBalance:
+			balance = d.Uint64()
+		case FieldLineBalance:
+			line = d.Int64()
+		case FieldIsVerified:
+			verified = d.Bool()
+		case FieldCloseTime:
+			closeTime = d.Time()
+		case FieldHash:
+			hash = d.Blob()
+		case FieldAccount:
+			account = d.String()
+		case FieldUNL:
+			unl = d.StringArray()
+		default:
+			d.Skip()
+		}
+	}
+	if err := d.Err(); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+
+	want := time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)
+	if flags != 7 || balance != 1000 || line != -25 || !verified || !closeTime.Equal(want) ||
+		!bytes.Equal(hash, []byte{1, 2, 3}) || account != "alice" || len(unl) != 2 || unl[1] != "n2" {
+		t.Fatal("decoded values differ from the encoded ones")
+	}
+}
+
+func TestZeroValuesAreOmitted(t *testing.T) {
+	e := NewEncoder()
+	e.Uint64(FieldBalance, 0)
+	e.String(FieldAccount, "")
+	e.Bool(FieldKYCVerified, false)
+	e.Time(FieldCloseTime, time.Time{})
+	data, err := e.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 0 {
+		t.Fatalf("expected empty encoding, got %x", data)
+	}
+}
+
+func TestEncoderErrors(t *testing.T) {
+	e := NewEncoder()
+	e.Uint64(FieldBalance, 1)
+	e.Uint64(FieldBalance, 2)
+	if _, err := e.Bytes(); !errors.Is(err, ErrDuplicateField) {
+		t.Fatalf("expected ErrDuplicateField, got %v", err)
+	}
+
+	e = NewEncoder()
+	e.String(FieldBalance, "1")
+	if _, err := e.Bytes(); !errors.Is(err, ErrFieldType) {
+		t.Fatalf("expected ErrFieldType, got %v", err)
+	}
+}
+
+func decodeAll(data []byte) error {
+	d := NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case FieldBalance:
+			d.Uint64()
+		case FieldAccount:
+			_ = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+func TestDecoderRejectsNonCanonicalInput(t *testing.T) {
+	e := NewEncoder()
+	e.Uint64(FieldBalance, 5)
+	e.String(FieldAccount, "bob")
+	data, err := e.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := decodeAll(data); err != nil {
+		t.Fatalf("canonical input rejected: %v", err)
+	}
+
+	balance := data[:10]
+	account := data[10:]
+	zero := append([]byte{byte(TypeUint64), FieldBalance.Code()}, make([]byte, 8)...)
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"truncated", data[:len(data)-1], ErrTruncated},
+		{"out of order", append(append([]byte{}, account...), balance...), ErrFieldOrder},
+		{"repeated field", append(append([]byte{}, balance...), balance...), ErrFieldOrder},
+		{"zero value", zero, ErrNonCanonical},
+		{"unknown field", append(append([]byte{}, data...), byte(TypeArray), 0xff, 0), ErrUnknownField},
+	}
+	for _, tt := range tests {
+		if err := decodeAll(tt.data); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestOmit(t *testing.T) {
+	full := encodeSample(t, []int{0, 1, 2, 3, 4, 5, 6, 7})
+	want := encodeSample(t, []int{0, 1, 2, 3, 4, 5, 7})
+
+	got, err := Omit(full, FieldAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("Omit mismatch:\n got %x\nwant %x", got, want)
+	}
+
+	if _, err := Omit(full[:len(full)-1], FieldAccount); !errors.Is(err, ErrTruncated) {
+		t.Fatalf("expected ErrTruncated, got %v", err)
+	}
+}
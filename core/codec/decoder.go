@@ -0,0 +1,322 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrTruncated    = errors.New("codec: truncated input")
+	ErrFieldOrder   = errors.New("codec: fields out of canonical order")
+	ErrUnknownField = errors.New("codec: unknown field")
+	ErrNonCanonical = errors.New("codec: non canonical value")
+)
+
+// Decoder reads the fields of an encoded object in order. Typical use:
+//
+//	d := codec.NewDecoder(data)
+//	for d.Next() {
+//		switch d.Field() {
+//		case codec.FieldAccount:
+//			acc.AccountID = d.String()
+//		default:
+//			d.Skip()
+//		}
+//	}
+//	return d.Err()
+//
+// Decoding is strict: fields must be in canonical order, zero values must be
+// left out and the value readers must match the field type, so only the
+// canonical encoding of an object is accepted.
+type Decoder struct {
+	data  []byte
+	pos   int
+	field Field
+	read  bool
+	err   error
+}
+
+// NewDecoder returns a decoder reading data
+func NewDecoder(data []byte) *Decoder {
+	return &Decoder{data: data}
+}
+
+// Next moves to the next field and reports whether there is one
+func (d *Decoder) Next() bool {
+	if d.err != nil {
+		return false
+	}
+	if d.field != 0 && !d.read {
+		d.err = fmt.Errorf("%w: %v", ErrUnknownField, d.field)
+		return false
+	}
+	if d.pos == len(d.data) {
+		return false
+	}
+	if len(d.data)-d.pos < 2 {
+		d.err = ErrTruncated
+		return false
+	}
+
+	f := Field(uint16(d.data[d.pos])<<8 | uint16(d.data[d.pos+1]))
+	if f <= d.field {
+		d.err = fmt.Errorf("%w: %v after %v", ErrFieldOrder, f, d.field)
+		return false
+	}
+	d.pos += 2
+	d.field = f
+	d.read = false
+	return true
+}
+
+// Field returns the current field
+func (d *Decoder) Field() Field {
+	return d.field
+}
+
+// Err returns the first error met while decoding
+func (d *Decoder) Err() error {
+	if d.err == nil && d.field != 0 && !d.read {
+		return fmt.Errorf("%w: %v", ErrUnknownField, d.field)
+	}
+	return d.err
+}
+
+// Fail records err, met while decoding the value of the current field, as
+// the decoding error unless an earlier one was met
+func (d *Decoder) Fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+// Skip marks the current field as unknown, which fails the decoding
+func (d *Decoder) Skip() {
+	if d.err == nil {
+		d.err = fmt.Errorf("%w: %v", ErrUnknownField, d.field)
+	}
+}
+
+// value checks the type of the current field and returns its next n bytes
+func (d *Decoder) value(t TypeCode, n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if d.field.Type() != t {
+		d.err = fmt.Errorf("%w: %v read as %v", ErrFieldType, d.field, t)
+		return nil
+	}
+	if n < 0 || len(d.data)-d.pos < n {
+		d.err = ErrTruncated
+		return nil
+	}
+	v := d.data[d.pos : d.pos+n]
+	d.pos += n
+	d.read = true
+	return v
+}
+
+// bytes reads a length prefixed value of the current field
+func (d *Decoder) bytes(t TypeCode) []byte {
+	if d.err != nil {
+		return nil
+	}
+	n, size := binary.Uvarint(d.data[d.pos:])
+	if size <= 0 || n > uint64(len(d.data)) {
+		d.err = ErrTruncated
+		return nil
+	}
+	if n == 0 {
+		d.err = fmt.Errorf("%w: empty %v", ErrNonCanonical, d.field)
+		return nil
+	}
+	if d.field.Type() == t {
+		d.pos += size
+	}
+	return d.value(t, int(n))
+}
+
+func (d *Decoder) nonZero(zero bool) {
+	if zero && d.err == nil {
+		d.err = fmt.Errorf("%w: zero %v", ErrNonCanonical, d.field)
+	}
+}
+
+func (d *Decoder) Uint32() uint32 {
+	v := d.value(TypeUint32, 4)
+	if v == nil {
+		return 0
+	}
+	n := binary.BigEndian.Uint32(v)
+	d.nonZero(n == 0)
+	return n
+}
+
+func (d *Decoder) Uint64() uint64 {
+	v := d.value(TypeUint64, 8)
+	if v == nil {
+		return 0
+	}
+	n := binary.BigEndian.Uint64(v)
+	d.nonZero(n == 0)
+	return n
+}
+
+func (d *Decoder) Int64() int64 {
+	v := d.value(TypeInt64, 8)
+	if v == nil {
+		return 0
+	}
+	n := int64(binary.BigEndian.Uint64(v))
+	d.nonZero(n == 0)
+	return n
+}
+
+func (d *Decoder) Bool() bool {
+	v := d.value(TypeBool, 1)
+	if v == nil {
+		return false
+	}
+	if v[0] != 1 && d.err == nil {
+		d.err = fmt.Errorf("%w: bool %v", ErrNonCanonical, d.field)
+	}
+	return true
+}
+
+func (d *Decoder) Time() time.Time {
+	v := d.value(TypeTime, 12)
+	if v == nil {
+		return time.Time{}
+	}
+	nsec := binary.BigEndian.Uint32(v[8:])
+	if nsec >= uint32(time.Second) && d.err == nil {
+		d.err = fmt.Errorf("%w: nanoseconds %v", ErrNonCanonical, d.field)
+	}
+	t := time.Unix(int64(binary.BigEndian.Uint64(v)), int64(nsec)).UTC()
+	d.nonZero(t.IsZero())
+	return t
+}
+
+func (d *Decoder) Blob() []byte {
+	return append([]byte(nil), d.bytes(TypeBlob)...)
+}
+
+func (d *Decoder) String() string {
+	v := d.bytes(TypeString)
+	if !utf8.Valid(v) && d.err == nil {
+		d.err = fmt.Errorf("%w: invalid UTF-8 in %v", ErrNonCanonical, d.field)
+	}
+	return string(v)
+}
+
+// Object returns the encoded form of a nested object
+func (d *Decoder) Object() []byte {
+	return d.bytes(TypeObject)
+}
+
+// Array returns the encoded form of each item of a list
+func (d *Decoder) Array() [][]byte {
+	if d.err != nil {
+		return nil
+	}
+	if d.field.Type() != TypeArray {
+		d.err = fmt.Errorf("%w: %v read as %v", ErrFieldType, d.field, TypeArray)
+		return nil
+	}
+
+	count, size := binary.Uvarint(d.data[d.pos:])
+	if size <= 0 || count > uint64(len(d.data)-d.pos) {
+		d.err = ErrTruncated
+		return nil
+	}
+	d.nonZero(count == 0)
+	d.pos += size
+
+	items := make([][]byte, 0, count)
+	for i := uint64(0); i < count; i++ {
+		n, size := binary.Uvarint(d.data[d.pos:])
+		if size <= 0 || n > uint64(len(d.data)-d.pos-size) {
+			d.err = ErrTruncated
+			return nil
+		}
+		start := d.pos + size
+		items = append(items, d.data[start:start+int(n)])
+		d.pos = start + int(n)
+	}
+	d.read = true
+	return items
+}
+
+// StringArray returns a list of strings
+func (d *Decoder) StringArray() []string {
+	items := d.Array()
+	if items == nil {
+		return nil
+	}
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = string(item)
+	}
+	return out
+}
+
+// skipValue reads past the value of the current field, whatever its type
+func (d *Decoder) skipValue() {
+	switch d.field.Type() {
+	case TypeUint32:
+		d.Uint32()
+	case TypeUint64:
+		d.Uint64()
+	case TypeInt64:
+		d.Int64()
+	case TypeBool:
+		d.Bool()
+	case TypeTime:
+		d.Time()
+	case TypeBlob, TypeString, TypeObject:
+		d.bytes(d.field.Type())
+	case TypeArray:
+		d.Array()
+	default:
+		d.Skip()
+	}
+}
+
+// Omit returns the canonical encoding data without the given fields, for
+// instance an object without the signature that covers it
+func Omit(data []byte, fields ...Field) ([]byte, error) {
+	var out []byte
+	d := NewDecoder(data)
+	for d.Next() {
+		start := d.pos - 2
+		d.skipValue()
+		if d.err == nil && !slices.Contains(fields, d.field) {
+			out = append(out, data[start:d.pos]...)
+		}
+	}
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
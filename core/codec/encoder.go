@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var (
+	ErrDuplicateField = errors.New("codec: duplicate field")
+	ErrFieldType      = errors.New("codec: value does not match the field type")
+)
+
+// encodedField is a field value waiting to be written in canonical order
+type encodedField struct {
+	field Field
+	value []byte
+}
+
+// Encoder builds the canonical encoding of an object. Fields may be added in
+// any order: they are sorted when the encoding is produced. Zero values are
+// ignored so that they are left out of the encoding.
+type Encoder struct {
+	fields []encodedField
+	err    error
+}
+
+// NewEncoder returns an empty encoder
+func NewEncoder() *Encoder {
+	return &Encoder{}
+}
+
+func (e *Encoder) add(f Field, t TypeCode, value []byte) {
+	if e.err != nil {
+		return
+	}
+	if f.Type() != t {
+		e.err = fmt.Errorf("%w: %v written as %v", ErrFieldType, f, t)
+		return
+	}
+	for _, ef := range e.fields {
+		if ef.field == f {
+			e.err = fmt.Errorf("%w: %v", ErrDuplicateField, f)
+			return
+		}
+	}
+	e.fields = append(e.fields, encodedField{field: f, value: value})
+}
+
+func (e *Encoder) Uint32(f Field, v uint32) {
+	if v == 0 {
+		return
+	}
+	e.add(f, TypeUint32, binary.BigEndian.AppendUint32(nil, v))
+}
+
+func (e *Encoder) Uint64(f Field, v uint64) {
+	if v == 0 {
+		return
+	}
+	e.add(f, TypeUint64, binary.BigEndian.AppendUint64(nil, v))
+}
+
+func (e *Encoder) Int64(f Field, v int64) {
+	if v == 0 {
+		return
+	}
+	e.add(f, TypeInt64, binary.BigEndian.AppendUint64(nil, uint64(v)))
+}
+
+func (e *Encoder) Bool(f Field, v bool) {
+	if !v {
+		return
+	}
+	e.add(f, TypeBool, []byte{1})
+}
+
+func (e *Encoder) Time(f Field, v time.Time) {
+	if v.IsZero() {
+		return
+	}
+	b := binary.BigEndian.AppendUint64(nil, uint64(v.Unix()))
+	e.add(f, TypeTime, binary.BigEndian.AppendUint32(b, uint32(v.Nanosecond())))
+}
+
+func (e *Encoder) Blob(f Field, v []byte) {
+	if len(v) == 0 {
+		return
+	}
+	e.add(f, TypeBlob, appendBytes(nil, v))
+}
+
+func (e *Encoder) String(f Field, v string) {
+	if v == "" {
+		return
+	}
+	e.add(f, TypeString, appendBytes(nil, []byte(v)))
+}
+
+// Object adds a nested object, given in its encoded form
+func (e *Encoder) Object(f Field, v []byte) {
+	if len(v) == 0 {
+		return
+	}
+	e.add(f, TypeObject, appendBytes(nil, v))
+}
+
+// Array adds a list of items, each given in its encoded form
+func (e *Encoder) Array(f Field, items [][]byte) {
+	if len(items) == 0 {
+		return
+	}
+	b := binary.AppendUvarint(nil, uint64(len(items)))
+	for _, item := range items {
+		b = appendBytes(b, item)
+	}
+	e.add(f, TypeArray, b)
+}
+
+// StringArray adds a list of strings
+func (e *Encoder) StringArray(f Field, v []string) {
+	items := make([][]byte, len(v))
+	for i, s := range v {
+		items[i] = []byte(s)
+	}
+	e.Array(f, items)
+}
+
+// Bytes returns the canonical encoding of the fields added so far
+func (e *Encoder) Bytes() ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	fields := append([]encodedField(nil), e.fields...)
+	sort.Slice(fields, func(i, j int) bool { return fields[i].field < fields[j].field })
+
+	var out []byte
+	for _, ef := range fields {
+		out = append(out, byte(ef.field.Type()), ef.field.Code())
+		out = append(out, ef.value...)
+	}
+	return out, nil
+}
+
+func appendBytes(b, v []byte) []byte {
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
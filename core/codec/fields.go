@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package codec
+
+// Field dictionary shared by every encoded object. Codes must never be
+// reused or renumbered, since that would change existing hashes.
+var (
+	FieldTxType     = newField(TypeUint32, 1)
+	FieldFlags      = newField(TypeUint32, 2)
+	FieldQualityIn  = newField(TypeUint32, 3)
+	FieldQualityOut = newField(TypeUint32, 4)
+	FieldNetworkID  = newField(TypeUint32, 5)
+	FieldAssetType  = newField(TypeUint32, 6)
+
+	FieldSetFlags       = newField(TypeUint32, 7)
+	FieldClearFlags     = newField(TypeUint32, 8)
+	FieldTransferRate   = newField(TypeUint32, 9)
+	FieldClear          = newField(TypeUint32, 10)
+	FieldDestinationTag = newField(TypeUint32, 11)
+	FieldSignerQuorum   = newField(TypeUint32, 12)
+	FieldSignerWeight   = newField(TypeUint32, 13)
+	FieldOwnerCount     = newField(TypeUint32, 14)
+	FieldSettleDelay    = newField(TypeUint32, 15)
+	FieldMessageType    = newField(TypeUint32, 16)
+	FieldVersion        = newField(TypeUint32, 17)
+	FieldRound          = newField(TypeUint32, 18)
+	FieldDataType       = newField(TypeUint32, 19)
+	FieldPort           = newField(TypeUint32, 20)
+
+	FieldIndex      = newField(TypeUint64, 1)
+	FieldSequence   = newField(TypeUint64, 2)
+	FieldFee        = newField(TypeUint64, 3)
+	FieldBalance    = newField(TypeUint64, 4)
+	FieldLimit      = newField(TypeUint64, 6)
+	FieldTotalCoins = newField(TypeUint64, 7)
+	FieldBaseFee    = newField(TypeUint64, 8)
+	FieldValue      = newField(TypeUint64, 9)
+
+	FieldOfferSequence   = newField(TypeUint64, 10)
+	FieldEscrowSequence  = newField(TypeUint64, 11)
+	FieldChannelSequence = newField(TypeUint64, 12)
+	FieldBaseReserve     = newField(TypeUint64, 13)
+	FieldOwnerReserve    = newField(TypeUint64, 14)
+
+	FieldLineBalance = newField(TypeInt64, 1)
+
+	FieldKYCVerified = newField(TypeBool, 1)
+	FieldIsVerified  = newField(TypeBool, 2)
+	FieldIsTokenized = newField(TypeBool, 3)
+	FieldIsEncrypted = newField(TypeBool, 4)
+	FieldClose       = newField(TypeBool, 5)
+
+	FieldTimestamp    = newField(TypeTime, 1)
+	FieldCloseTime    = newField(TypeTime, 2)
+	FieldExpiresAt    = newField(TypeTime, 3)
+	FieldKYCTimestamp = newField(TypeTime, 4)
+	FieldFinishAfter  = newField(TypeTime, 5)
+	FieldCancelAfter  = newField(TypeTime, 6)
+	FieldExpiration   = newField(TypeTime, 7)
+
+	FieldHash          = newField(TypeBlob, 1)
+	FieldParentHash    = newField(TypeBlob, 2)
+	FieldStateHash     = newField(TypeBlob, 3)
+	FieldTxHash        = newField(TypeBlob, 4)
+	FieldKYCHash       = newField(TypeBlob, 5)
+	FieldKYCSignature  = newField(TypeBlob, 6)
+	FieldLegalHash     = newField(TypeBlob, 7)
+	FieldBiometricHash = newField(TypeBlob, 8)
+	FieldCondition     = newField(TypeBlob, 9)
+	FieldFulfillment   = newField(TypeBlob, 10)
+	FieldAmountValue   = newField(TypeBlob, 11)
+	FieldLimitValue    = newField(TypeBlob, 12)
+	FieldBalanceValue  = newField(TypeBlob, 13)
+	FieldPayload       = newField(TypeBlob, 14)
+	FieldQuality       = newField(TypeBlob, 15)
+
+	FieldAccount        = newField(TypeString, 1)
+	FieldDestination    = newField(TypeString, 2)
+	FieldCurrency       = newField(TypeString, 3)
+	FieldIssuer         = newField(TypeString, 4)
+	FieldSignature      = newField(TypeString, 5)
+	FieldID             = newField(TypeString, 6)
+	FieldDescription    = newField(TypeString, 7)
+	FieldFullName       = newField(TypeString, 8)
+	FieldIDNumber       = newField(TypeString, 9)
+	FieldDateOfBirth    = newField(TypeString, 10)
+	FieldNationality    = newField(TypeString, 11)
+	FieldAddress        = newField(TypeString, 12)
+	FieldSigningPubKey  = newField(TypeString, 13)
+	FieldKYCProvider    = newField(TypeString, 14)
+	FieldDomain         = newField(TypeString, 15)
+	FieldMessageKey     = newField(TypeString, 16)
+	FieldOwner          = newField(TypeString, 17)
+	FieldPublicKey      = newField(TypeString, 18)
+	FieldClaimSignature = newField(TypeString, 19)
+
+	FieldKYCData = newField(TypeObject, 1)
+	FieldAmount  = newField(TypeObject, 2)
+
+	FieldTakerPays = newField(TypeObject, 3)
+	FieldTakerGets = newField(TypeObject, 4)
+
+	FieldTrustLines   = newField(TypeArray, 1)
+	FieldAssets       = newField(TypeArray, 2)
+	FieldConditions   = newField(TypeArray, 3)
+	FieldUNL          = newField(TypeArray, 4)
+	FieldTransactions = newField(TypeArray, 5)
+	FieldKYCProviders = newField(TypeArray, 6)
+	FieldOffers       = newField(TypeArray, 7)
+	FieldSigners      = newField(TypeArray, 8)
+	FieldSignerList   = newField(TypeArray, 9)
+	FieldHashes       = newField(TypeArray, 10)
+	FieldLedgerData   = newField(TypeArray, 11)
+)
+
+var fieldNames = map[Field]string{
+	FieldTxType:          "TxType",
+	FieldFlags:           "Flags",
+	FieldQualityIn:       "QualityIn",
+	FieldQualityOut:      "QualityOut",
+	FieldNetworkID:       "NetworkID",
+	FieldAssetType:       "AssetType",
+	FieldSetFlags:        "SetFlags",
+	FieldClearFlags:      "ClearFlags",
+	FieldTransferRate:    "TransferRate",
+	FieldClear:           "Clear",
+	FieldDestinationTag:  "DestinationTag",
+	FieldSignerQuorum:    "SignerQuorum",
+	FieldSignerWeight:    "SignerWeight",
+	FieldOwnerCount:      "OwnerCount",
+	FieldSettleDelay:     "SettleDelay",
+	FieldMessageType:     "MessageType",
+	FieldVersion:         "Version",
+	FieldRound:           "Round",
+	FieldDataType:        "DataType",
+	FieldPort:            "Port",
+	FieldIndex:           "Index",
+	FieldSequence:        "Sequence",
+	FieldFee:             "Fee",
+	FieldBalance:         "Balance",
+	FieldLimit:           "Limit",
+	FieldTotalCoins:      "TotalCoins",
+	FieldBaseFee:         "BaseFee",
+	FieldValue:           "Value",
+	FieldOfferSequence:   "OfferSequence",
+	FieldEscrowSequence:  "EscrowSequence",
+	FieldChannelSequence: "ChannelSequence",
+	FieldBaseReserve:     "BaseReserve",
+	FieldOwnerReserve:    "OwnerReserve",
+	FieldLineBalance:     "LineBalance",
+	FieldKYCVerified:     "KYCVerified",
+	FieldIsVerified:      "IsVerified",
+	FieldIsTokenized:     "IsTokenized",
+	FieldIsEncrypted:     "IsEncrypted",
+	FieldClose:           "Close",
+	FieldTimestamp:       "Timestamp",
+	FieldCloseTime:       "CloseTime",
+	FieldExpiresAt:       "ExpiresAt",
+	FieldKYCTimestamp:    "KYCTimestamp",
+	FieldFinishAfter:     "FinishAfter",
+	FieldCancelAfter:     "CancelAfter",
+	FieldExpiration:      "Expiration",
+	FieldHash:            "Hash",
+	FieldParentHash:      "ParentHash",
+	FieldStateHash:       "StateHash",
+	FieldTxHash:          "TxHash",
+	FieldKYCHash:         "KYCHash",
+	FieldKYCSignature:    "KYCSignature",
+	FieldLegalHash:       "LegalHash",
+	FieldBiometricHash:   "BiometricHash",
+	FieldCondition:       "Condition",
+	FieldFulfillment:     "Fulfillment",
+	FieldAmountValue:     "AmountValue",
+	FieldLimitValue:      "LimitValue",
+	FieldBalanceValue:    "BalanceValue",
+	FieldPayload:         "Payload",
+	FieldQuality:         "Quality",
+	FieldAccount:         "Account",
+	FieldDestination:     "Destination",
+	FieldCurrency:        "Currency",
+	FieldIssuer:          "Issuer",
+	FieldSignature:       "Signature",
+	FieldID:              "ID",
+	FieldDescription:     "Description",
+	FieldFullName:        "FullName",
+	FieldIDNumber:        "IDNumber",
+	FieldDateOfBirth:     "DateOfBirth",
+	FieldNationality:     "Nationality",
+	FieldAddress:         "Address",
+	FieldSigningPubKey:   "SigningPubKey",
+	FieldKYCProvider:     "KYCProvider",
+	FieldDomain:          "Domain",
+	FieldMessageKey:      "MessageKey",
+	FieldOwner:           "Owner",
+	FieldPublicKey:       "PublicKey",
+	FieldClaimSignature:  "ClaimSignature",
+	FieldKYCData:         "KYCData",
+	FieldAmount:          "Amount",
+	FieldTakerPays:       "TakerPays",
+	FieldTakerGets:       "TakerGets",
+	FieldTrustLines:      "TrustLines",
+	FieldAssets:          "Assets",
+	FieldConditions:      "Conditions",
+	FieldUNL:             "UNL",
+	FieldTransactions:    "Transactions",
+	FieldKYCProviders:    "KYCProviders",
+	FieldOffers:          "Offers",
+	FieldSigners:         "Signers",
+	FieldSignerList:      "SignerList",
+	FieldHashes:          "Hashes",
+	FieldLedgerData:      "LedgerData",
+}
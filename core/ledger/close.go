@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ledger
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"github.com/ezcon-foundation/go-ezcon/core/transactor"
+)
+
+var ErrDuplicateTx = errors.New("ledger: duplicate transaction")
+
+// TxResult is the outcome of applying one transaction of a closing set
+type TxResult struct {
+	Tx  transaction.Transaction
+	Key block.Key
+	Err error
+}
+
+// candidate is a transaction with its serialized form and tree key
+type candidate struct {
+	tx   transaction.Transaction
+	blob []byte
+	key  block.Key
+}
+
+// BuildBlock applies txs on top of the state of parent and returns the next
+// block. Every node applying the same set to the same parent builds the same
+// block: transactions are applied ordered by account, sequence and key, and
+// the close time is rounded to the second. Transactions that fail are left
+// out of the block and reported in the results.
+func BuildBlock(parent *block.Block, txs []transaction.Transaction, closeTime time.Time) (*block.Block, []TxResult, error) {
+	candidates := make([]candidate, 0, len(txs))
+	for _, tx := range txs {
+		blob, err := tx.Serialize()
+		if err != nil {
+			return nil, nil, fmt.Errorf("ledger: serialize transaction: %w", err)
+		}
+		candidates = append(candidates, candidate{tx: tx, blob: blob, key: block.TransactionKey(blob)})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.tx.GetAccount() != b.tx.GetAccount() {
+			return a.tx.GetAccount() < b.tx.GetAccount()
+		}
+		if a.tx.GetSequence() != b.tx.GetSequence() {
+			return a.tx.GetSequence() < b.tx.GetSequence()
+		}
+		return bytes.Compare(a.key[:], b.key[:]) < 0
+	})
+
+	next := block.NewBlock(parent.Header.Index+1, parent.Header.Hash, parent.Header.TotalCoins)
+	next.Header.CloseTime = nextCloseTime(parent.Header.CloseTime, closeTime)
+
+	st := state.New(&parent.Accounts)
+	results := make([]TxResult, 0, len(candidates))
+
+	for _, c := range candidates {
+		result := TxResult{Tx: c.tx, Key: c.key}
+		if next.Transactions.Has(c.key) {
+			result.Err = ErrDuplicateTx
+			results = append(results, result)
+			continue
+		}
+
+		if err := transactor.Apply(st, c.tx, next.Header.CloseTime); err != nil {
+			result.Err = err
+			results = append(results, result)
+			continue
+		}
+
+		// phí giao dịch bị đốt, không chuyển cho ai
+		next.Header.TotalCoins -= c.tx.GetFee()
+		next.Transactions.Set(c.key, c.blob)
+		results = append(results, result)
+	}
+
+	next.Accounts = *st.Tree()
+	next.UpdateRoots()
+	next.Header.Hash = next.Header.ComputeHash()
+
+	return next, results, nil
+}
+
+// nextCloseTime rounds the close time to the second and keeps it after the
+// parent close time
+func nextCloseTime(parent, closeTime time.Time) time.Time {
+	closeTime = closeTime.UTC().Truncate(time.Second)
+	if !closeTime.After(parent) {
+		closeTime = parent.UTC().Truncate(time.Second).Add(time.Second)
+	}
+	return closeTime
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/storage"
+	"github.com/ezcon-foundation/go-ezcon/core/transactor"
+)
+
+var (
+	ErrGenesisExists  = errors.New("ledger: store already holds a ledger")
+	ErrGenesisSupply  = errors.New("ledger: genesis balances exceed total coins")
+	ErrGenesisAccount = errors.New("ledger: invalid genesis account")
+	ErrGenesisUNL     = errors.New("ledger: invalid genesis validator")
+)
+
+// GenesisSpec describes ledger 0 of a network
+type GenesisSpec struct {
+	NetworkID  uint32             `toml:"network_id"`
+	CloseTime  time.Time          `toml:"close_time"`
+	TotalCoins uint64             `toml:"total_coins"`
+	BaseFee    uint64             `toml:"base_fee"`
+	UNL        []GenesisValidator `toml:"validators"`
+	Accounts   []GenesisAccount   `toml:"accounts"`
+
+	// BaseReserve and OwnerReserve are the account reserves in drops, see
+	// state.Params
+	BaseReserve  uint64 `toml:"base_reserve"`
+	OwnerReserve uint64 `toml:"owner_reserve"`
+
+	// KYCProviders are the addresses of the recognised KYC providers
+	KYCProviders []string `toml:"kyc_providers"`
+}
+
+// GenesisValidator is a validator of the initial UNL
+type GenesisValidator struct {
+	NodeID    string `toml:"node_id"`
+	PublicKey string `toml:"public_key"`
+}
+
+// GenesisAccount is an account funded in ledger 0
+type GenesisAccount struct {
+	AccountID string `toml:"account_id"`
+	Balance   uint64 `toml:"balance"`
+}
+
+// LoadGenesisSpec reads a genesis spec from a TOML file
+func LoadGenesisSpec(path string) (*GenesisSpec, error) {
+	var spec GenesisSpec
+	if _, err := toml.DecodeFile(path, &spec); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
+
+// Genesis builds ledger 0 from the spec. The block only depends on the spec,
+// so every node derives the same genesis hash from the same file.
+func Genesis(spec *GenesisSpec) (*block.Block, error) {
+	genesis := block.NewBlock(0, nil, spec.TotalCoins)
+	genesis.Header.CloseTime = spec.CloseTime.UTC().Truncate(time.Second)
+
+	st := state.New(&genesis.Accounts)
+
+	params := &state.Params{
+		NetworkID:    spec.NetworkID,
+		BaseFee:      spec.BaseFee,
+		BaseReserve:  spec.BaseReserve,
+		OwnerReserve: spec.OwnerReserve,
+	}
+	for _, v := range spec.UNL {
+		if _, err := address.ParseNodePublicKey(v.PublicKey); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrGenesisUNL, v.NodeID, err)
+		}
+		params.UNL = append(params.UNL, v.PublicKey)
+	}
+	for _, provider := range spec.KYCProviders {
+		if err := address.ValidateAccount(provider); err != nil {
+			return nil, fmt.Errorf("%w: kyc provider %q: %v", ErrGenesisAccount, provider, err)
+		}
+		params.KYCProviders = append(params.KYCProviders, provider)
+	}
+	if err := st.SetParams(params); err != nil {
+		return nil, err
+	}
+
+	var funded uint64
+	for _, a := range spec.Accounts {
+		if err := address.ValidateAccount(a.AccountID); err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrGenesisAccount, a.AccountID, err)
+		}
+		if st.HasAccount(a.AccountID) {
+			return nil, fmt.Errorf("%w: duplicate account %s", ErrGenesisAccount, a.AccountID)
+		}
+		funded += a.Balance
+		if funded < a.Balance || funded > spec.TotalCoins {
+			return nil, ErrGenesisSupply
+		}
+
+		if err := transactor.Fund(st, a.AccountID, a.Balance); err != nil {
+			return nil, err
+		}
+	}
+
+	genesis.Accounts = *st.Tree()
+	genesis.UpdateRoots()
+	genesis.Header.Hash = genesis.Header.ComputeHash()
+	return genesis, nil
+}
+
+// InitGenesis builds ledger 0 and writes it to an empty store
+func InitGenesis(store *storage.LedgerStore, spec *GenesisSpec) (*block.Block, error) {
+	if _, err := store.ReadLatestBlock(); err == nil {
+		return nil, ErrGenesisExists
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+
+	genesis, err := Genesis(spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := store.WriteBlock(genesis); err != nil {
+		return nil, err
+	}
+	return genesis, nil
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ledger
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/storage"
+)
+
+const testGenesisSpec = `
+network_id = 7
+close_time = 2025-01-01T00:00:00Z
+total_coins = 100000000000
+base_fee = 10
+
+[[validators]]
+node_id = "node1"
+public_key = "%s"
+
+[[accounts]]
+account_id = "%s"
+balance = 60000000000
+
+[[accounts]]
+account_id = "%s"
+balance = 40000000000
+`
+
+func loadTestSpec(t *testing.T) *GenesisSpec {
+	_, alice := testKey(t, "alice")
+	_, bob := testKey(t, "bob")
+	text := fmt.Sprintf(testGenesisSpec, testNodeKey(t, "node1"), alice, bob)
+
+	path := filepath.Join(t.TempDir(), "genesis.toml")
+	if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	spec, err := LoadGenesisSpec(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return spec
+}
+
+func TestGenesis(t *testing.T) {
+	spec := loadTestSpec(t)
+	genesis, err := Genesis(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// account order in the spec does not change the ledger
+	spec.Accounts[0], spec.Accounts[1] = spec.Accounts[1], spec.Accounts[0]
+	again, err := Genesis(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(genesis.Header.Hash, again.Header.Hash) {
+		t.Fatal("genesis hash is not deterministic")
+	}
+
+	_, alice := testKey(t, "alice")
+	st := state.New(&genesis.Accounts)
+	acc, err := st.Account(alice)
+	if err != nil || acc.Balance != 60000000000 {
+		t.Fatalf("got %+v %v", acc, err)
+	}
+	params, err := st.Params()
+	if err != nil || params.NetworkID != 7 || len(params.UNL) != 1 {
+		t.Fatalf("got params %+v %v", params, err)
+	}
+
+	bad := *spec
+	bad.Accounts = append([]GenesisAccount{{AccountID: "alice", Balance: 1}}, spec.Accounts[1:]...)
+	if _, err := Genesis(&bad); !errors.Is(err, ErrGenesisAccount) {
+		t.Fatalf("invalid account address: got %v", err)
+	}
+	bad = *spec
+	bad.UNL = []GenesisValidator{{NodeID: "node1", PublicKey: alice}}
+	if _, err := Genesis(&bad); !errors.Is(err, ErrGenesisUNL) {
+		t.Fatalf("account address as validator key: got %v", err)
+	}
+
+	spec.TotalCoins = 1
+	if _, err := Genesis(spec); !errors.Is(err, ErrGenesisSupply) {
+		t.Fatalf("overfunded genesis: got %v", err)
+	}
+}
+
+func TestInitGenesis(t *testing.T) {
+	store := storage.NewLedgerStore(storage.NewMemoryDB())
+	spec := loadTestSpec(t)
+
+	genesis, err := InitGenesis(store, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := InitGenesis(store, spec); err != ErrGenesisExists {
+		t.Fatalf("second genesis: got %v", err)
+	}
+
+	l, err := Load(store)
+	if err != nil || !bytes.Equal(l.Closed().Header.Hash, genesis.Header.Hash) {
+		t.Fatalf("genesis not loaded: %v", err)
+	}
+}
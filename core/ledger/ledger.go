@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ledger
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/storage"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+var ErrNoLedger = errors.New("ledger: no closed ledger")
+
+// Ledger tracks the last closed block and closes the next ones on top of it.
+// It also tracks the last fully validated block, the latest one a quorum of
+// validators agreed on; only its transactions are final.
+type Ledger struct {
+	store     *storage.LedgerStore
+	closed    *block.Block
+	validated *block.Block
+	mutex     sync.RWMutex
+}
+
+// New returns a ledger whose last closed block is closed. closed may be nil
+// until a genesis block is written.
+func New(store *storage.LedgerStore, closed *block.Block) *Ledger {
+	return &Ledger{store: store, closed: closed}
+}
+
+// Load restores the ledger from the latest and the validated block in the
+// store. It returns storage.ErrNotFound if the store holds no block yet.
+func Load(store *storage.LedgerStore) (*Ledger, error) {
+	latest, err := store.ReadLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+	l := New(store, latest)
+
+	l.validated, err = store.ReadValidatedBlock()
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Closed returns the last closed block
+func (l *Ledger) Closed() *block.Block {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.closed
+}
+
+// Validated returns the last fully validated block, nil until a block has
+// been validated
+func (l *Ledger) Validated() *block.Block {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.validated
+}
+
+// SetValidated marks the stored block with the given hash as fully
+// validated. Validation only moves forward: a block older than the current
+// validated block is ignored.
+func (l *Ledger) SetValidated(hash []byte) (*block.Block, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	b, err := l.store.ReadBlockByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if l.validated != nil && b.Header.Index <= l.validated.Header.Index {
+		return l.validated, nil
+	}
+	if err := l.store.WriteValidated(hash); err != nil {
+		return nil, err
+	}
+	l.validated = b
+	return b, nil
+}
+
+// ValidatedHeader returns the header of the block at index on the validated
+// chain, found by walking back from the validated block through parent
+// hashes. Blocks closed on an abandoned fork are never returned. It returns
+// nil if no block at index has been validated yet.
+func (l *Ledger) ValidatedHeader(index uint64) (*block.BlockHeader, error) {
+	validated := l.Validated()
+	if validated == nil || index > validated.Header.Index {
+		return nil, nil
+	}
+
+	header := &validated.Header
+	for header.Index > index {
+		parent, err := l.store.ReadHeader(header.ParentHash)
+		if err != nil {
+			return nil, err
+		}
+		header = parent
+	}
+	return header, nil
+}
+
+// Close builds the next block from the agreed transaction set, stores it
+// and makes it the last closed block
+func (l *Ledger) Close(txs []transaction.Transaction, closeTime time.Time) (*block.Block, []TxResult, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.closed == nil {
+		return nil, nil, ErrNoLedger
+	}
+
+	next, results, err := BuildBlock(l.closed, txs, closeTime)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := l.store.WriteBlock(next); err != nil {
+		return nil, nil, err
+	}
+
+	l.closed = next
+	return next, results, nil
+}
+
+// Switch stores b and makes it the last closed block in place of the
+// current one. It is used when the network validated a block this node did
+// not close, once the block has been acquired from peers and verified.
+func (l *Ledger) Switch(b *block.Block) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if err := l.store.WriteBlock(b); err != nil {
+		return err
+	}
+	l.closed = b
+	return nil
+}
@@ -0,0 +1,272 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ledger
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/storage"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"github.com/ezcon-foundation/go-ezcon/core/transactor"
+	"github.com/ezcon-foundation/go-ezcon/crypto"
+	"github.com/ezcon-foundation/go-ezcon/internal/testkey"
+)
+
+// testKey returns the private key derived from name and the ID of the
+// account it controls
+func testKey(t *testing.T, name string) (crypto.PrivateKey, string) {
+	t.Helper()
+	return testkey.Account(t, name)
+}
+
+// testNodeKey returns the text form of a node public key derived from name
+func testNodeKey(t *testing.T, name string) string {
+	t.Helper()
+	_, pubKey := testkey.New(t, name)
+	return address.EncodeNodePublicKey(pubKey)
+}
+
+func testParent(t *testing.T) *block.Block {
+	parent := block.NewBlock(0, nil, 1_000_000)
+	st := state.New(&parent.Accounts)
+	for _, name := range []string{"alice", "bob"} {
+		_, id := testKey(t, name)
+		if err := st.SetAccount(&account.Account{AccountID: id, Balance: 1000, Sequence: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	parent.Accounts = *st.Tree()
+	parent.UpdateRoots()
+	parent.Header.Hash = parent.Header.ComputeHash()
+	return parent
+}
+
+func trustSet(t *testing.T, seq, fee uint64) *transaction.TrustSet {
+	t.Helper()
+	priv, alice := testKey(t, "alice")
+	_, bob := testKey(t, "bob")
+	tx := &transaction.TrustSet{
+		BaseTransaction: transaction.BaseTransaction{TxType: "TrustSet", Account: alice, Sequence: seq, Fee: fee},
+		Destination:     bob,
+		Currency:        "USD",
+		Limit:           amount.FromUint64(100),
+	}
+	if err := transaction.Sign(tx, priv); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestBuildBlock(t *testing.T) {
+	parent := testParent(t)
+	closeTime := time.Unix(1_700_000_000, 0)
+
+	txs := []transaction.Transaction{trustSet(t, 2, 10), trustSet(t, 1, 10), trustSet(t, 5, 10)}
+	next, results, err := BuildBlock(parent, txs, closeTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if next.Header.Index != 1 || !bytes.Equal(next.Header.ParentHash, parent.Header.Hash) {
+		t.Fatal("block is not chained to its parent")
+	}
+	if next.Header.TotalCoins != parent.Header.TotalCoins-20 {
+		t.Fatalf("got total coins %d, want fees burned", next.Header.TotalCoins)
+	}
+	if next.Transactions.Len() != 2 || !errors.Is(results[2].Err, transactor.ErrBadSequence) {
+		t.Fatalf("got %d transactions, results %+v", next.Transactions.Len(), results)
+	}
+	if !bytes.Equal(next.Header.Hash, next.Header.ComputeHash()) || !bytes.Equal(next.Header.StateHash, next.Accounts.RootHash()) {
+		t.Fatal("header hashes are not up to date")
+	}
+
+	_, alice := testKey(t, "alice")
+	acc, err := state.New(&next.Accounts).Account(alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acc.Balance != 980 || acc.Sequence != 3 || len(acc.TrustLines) != 1 {
+		t.Fatalf("got account %+v", acc)
+	}
+
+	// the parent state is left untouched
+	if !bytes.Equal(parent.Accounts.RootHash(), parent.Header.StateHash) {
+		t.Fatal("parent state was modified")
+	}
+
+	again, _, err := BuildBlock(parent, []transaction.Transaction{trustSet(t, 1, 10), trustSet(t, 2, 10)}, closeTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(again.Header.Hash, next.Header.Hash) {
+		t.Fatal("same set on the same parent built a different block")
+	}
+}
+
+func TestLedgerClose(t *testing.T) {
+	store := storage.NewLedgerStore(storage.NewMemoryDB())
+	parent := testParent(t)
+	if err := store.WriteBlock(parent); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := Load(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed, _, err := l.Close([]transaction.Transaction{trustSet(t, 1, 10)}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Closed() != closed {
+		t.Fatal("ledger did not advance")
+	}
+
+	reloaded, err := Load(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(reloaded.Closed().Header.Hash, closed.Header.Hash) {
+		t.Fatal("closed block was not stored")
+	}
+	if reloaded.Validated() != nil {
+		t.Fatal("block validated without validations")
+	}
+}
+
+func TestLedgerValidated(t *testing.T) {
+	store := storage.NewLedgerStore(storage.NewMemoryDB())
+	parent := testParent(t)
+	if err := store.WriteBlock(parent); err != nil {
+		t.Fatal(err)
+	}
+	l, err := Load(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed, _, err := l.Close(nil, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := l.SetValidated([]byte("unknown")); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("validating an unknown block: got %v", err)
+	}
+	if _, err := l.SetValidated(closed.Header.Hash); err != nil {
+		t.Fatal(err)
+	}
+
+	// an older block does not move validation back
+	if b, err := l.SetValidated(parent.Header.Hash); err != nil || b.Header.Index != closed.Header.Index {
+		t.Fatalf("got validated block %v, err %v", b, err)
+	}
+
+	reloaded, err := Load(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := reloaded.Validated(); v == nil || !bytes.Equal(v.Header.Hash, closed.Header.Hash) {
+		t.Fatal("validated block was not stored")
+	}
+}
+
+func TestLedgerSwitch(t *testing.T) {
+	store := storage.NewLedgerStore(storage.NewMemoryDB())
+	parent := testParent(t)
+	if err := store.WriteBlock(parent); err != nil {
+		t.Fatal(err)
+	}
+	l, err := Load(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the network closed a different block on the same parent
+	other, _, err := BuildBlock(parent, []transaction.Transaction{trustSet(t, 1, 10)}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := l.Close(nil, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := l.Switch(other); err != nil {
+		t.Fatal(err)
+	}
+	if l.Closed() != other {
+		t.Fatal("ledger did not switch")
+	}
+	reloaded, err := Load(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(reloaded.Closed().Header.Hash, other.Header.Hash) {
+		t.Fatal("switched block is not the latest stored block")
+	}
+}
+
+func TestLedgerValidatedHeader(t *testing.T) {
+	store := storage.NewLedgerStore(storage.NewMemoryDB())
+	parent := testParent(t)
+	if err := store.WriteBlock(parent); err != nil {
+		t.Fatal(err)
+	}
+	l, err := Load(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the node closes a block on a fork, the network validates another one
+	fork, _, err := l.Close(nil, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, _, err := BuildBlock(parent, []transaction.Transaction{trustSet(t, 1, 10)}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Switch(other); err != nil {
+		t.Fatal(err)
+	}
+	next, _, err := l.Close(nil, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.SetValidated(next.Header.Hash); err != nil {
+		t.Fatal(err)
+	}
+
+	header, err := l.ValidatedHeader(fork.Header.Index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header == nil || !bytes.Equal(header.Hash, other.Header.Hash) {
+		t.Fatal("validated chain goes through the fork")
+	}
+	if header, err := l.ValidatedHeader(next.Header.Index + 1); err != nil || header != nil {
+		t.Fatalf("got header %v for a block not validated yet, err %v", header, err)
+	}
+}
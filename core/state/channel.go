@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package state
+
+import (
+	"errors"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/block/channel"
+)
+
+var ErrChannelNotFound = errors.New("state: channel not found")
+
+// Channel loads the payment channel an account created with the given
+// sequence, or returns ErrChannelNotFound
+func (s *State) Channel(accountID string, sequence uint64) (*channel.Channel, error) {
+	data, ok := s.tree.Get(block.ChannelKey(accountID, sequence))
+	if !ok {
+		return nil, ErrChannelNotFound
+	}
+	var c channel.Channel
+	if err := c.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// SetChannel creates or updates a payment channel
+func (s *State) SetChannel(c *channel.Channel) error {
+	data, err := c.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	s.tree.Set(block.ChannelKey(c.Account, c.Sequence), data)
+	return nil
+}
+
+// DeleteChannel removes a payment channel
+func (s *State) DeleteChannel(accountID string, sequence uint64) error {
+	err := s.tree.Delete(block.ChannelKey(accountID, sequence))
+	if errors.Is(err, block.ErrKeyNotFound) {
+		return ErrChannelNotFound
+	}
+	return err
+}
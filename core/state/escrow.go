@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package state
+
+import (
+	"errors"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/block/escrow"
+)
+
+var ErrEscrowNotFound = errors.New("state: escrow not found")
+
+// Escrow loads the escrow an account created with the given sequence, or
+// returns ErrEscrowNotFound
+func (s *State) Escrow(accountID string, sequence uint64) (*escrow.Escrow, error) {
+	data, ok := s.tree.Get(block.EscrowKey(accountID, sequence))
+	if !ok {
+		return nil, ErrEscrowNotFound
+	}
+	var e escrow.Escrow
+	if err := e.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// SetEscrow creates or updates an escrow
+func (s *State) SetEscrow(e *escrow.Escrow) error {
+	data, err := e.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	s.tree.Set(block.EscrowKey(e.Account, e.Sequence), data)
+	return nil
+}
+
+// DeleteEscrow removes an escrow
+func (s *State) DeleteEscrow(accountID string, sequence uint64) error {
+	err := s.tree.Delete(block.EscrowKey(accountID, sequence))
+	if errors.Is(err, block.ErrKeyNotFound) {
+		return ErrEscrowNotFound
+	}
+	return err
+}
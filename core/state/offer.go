@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package state
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/block/offer"
+)
+
+var ErrOfferNotFound = errors.New("state: offer not found")
+
+// Offer loads the offer an account placed with the given sequence, or
+// returns ErrOfferNotFound
+func (s *State) Offer(accountID string, sequence uint64) (*offer.Offer, error) {
+	data, ok := s.tree.Get(block.OfferKey(accountID, sequence))
+	if !ok {
+		return nil, ErrOfferNotFound
+	}
+	var o offer.Offer
+	if err := o.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// SetOffer creates or updates an offer
+func (s *State) SetOffer(o *offer.Offer) error {
+	data, err := o.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	s.tree.Set(block.OfferKey(o.Account, o.Sequence), data)
+	return nil
+}
+
+// DeleteOffer removes an offer
+func (s *State) DeleteOffer(accountID string, sequence uint64) error {
+	err := s.tree.Delete(block.OfferKey(accountID, sequence))
+	if errors.Is(err, block.ErrKeyNotFound) {
+		return ErrOfferNotFound
+	}
+	return err
+}
+
+// Directory loads the offers of the order book whose takers pay pays and
+// get gets placed at quality q. A quality with no offers is returned empty.
+func (s *State) Directory(pays, gets amount.Issue, q offer.Quality) (*offer.Directory, error) {
+	var dir offer.Directory
+	data, ok := s.tree.Get(bookKey(pays, gets, q))
+	if !ok {
+		return &dir, nil
+	}
+	if err := dir.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return &dir, nil
+}
+
+// SetDirectory stores the offers of an order book at quality q, removing the
+// directory from the tree once it is empty
+func (s *State) SetDirectory(pays, gets amount.Issue, q offer.Quality, dir *offer.Directory) error {
+	key := bookKey(pays, gets, q)
+	if len(dir.Offers) == 0 {
+		if s.tree.Has(key) {
+			return s.tree.Delete(key)
+		}
+		return nil
+	}
+	data, err := dir.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	s.tree.Set(key, data)
+	return nil
+}
+
+// NextQuality returns the lowest quality above after at which the order book
+// whose takers pay pays and get gets has offers, and false when there is
+// none. Starting from the zero Quality visits the book best quality first.
+func (s *State) NextQuality(pays, gets amount.Issue, after offer.Quality) (offer.Quality, bool) {
+	key, _, ok := s.tree.Next(bookKey(pays, gets, after))
+	if !ok {
+		return offer.Quality{}, false
+	}
+	base := bookKey(pays, gets, offer.Quality{})
+	if !bytes.Equal(key[:block.KeySize-offer.QualitySize], base[:block.KeySize-offer.QualitySize]) {
+		return offer.Quality{}, false
+	}
+	var q offer.Quality
+	copy(q[:], key[block.KeySize-offer.QualitySize:])
+	return q, true
+}
+
+func bookKey(pays, gets amount.Issue, q offer.Quality) block.Key {
+	return block.BookKey(pays.Currency, pays.Issuer, gets.Currency, gets.Issuer, q[:])
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package state
+
+import (
+	"errors"
+	"math"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+var ErrParamsNotFound = errors.New("state: network parameters not found")
+
+// Params holds the network parameters recorded in the ledger. They are set
+// by the genesis ledger and shared by every node of the network.
+type Params struct {
+	NetworkID uint32   `json:"network_id"`
+	BaseFee   uint64   `json:"base_fee"` // Minimum fee of a transaction (drops)
+	UNL       []string `json:"unl"`      // Public keys of the trusted validators
+
+	// BaseReserve is the balance every account must keep and OwnerReserve
+	// the extra balance kept for each object it owns (drops)
+	BaseReserve  uint64 `json:"base_reserve"`
+	OwnerReserve uint64 `json:"owner_reserve"`
+
+	// KYCProviders are the addresses of the providers whose KYC attestations
+	// are accepted by KYCSet
+	KYCProviders []string `json:"kyc_providers"`
+}
+
+// IsKYCProvider reports whether addr is a recognised KYC provider
+func (p *Params) IsKYCProvider(addr string) bool {
+	for _, provider := range p.KYCProviders {
+		if provider == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// Reserve returns the balance an account owning ownerCount objects must keep
+func (p *Params) Reserve(ownerCount uint32) uint64 {
+	owner := p.OwnerReserve * uint64(ownerCount)
+	if ownerCount != 0 && owner/uint64(ownerCount) != p.OwnerReserve {
+		return math.MaxUint64
+	}
+	if owner > math.MaxUint64-p.BaseReserve {
+		return math.MaxUint64
+	}
+	return p.BaseReserve + owner
+}
+
+// MarshalBinary returns the canonical encoding of the parameters
+func (p *Params) MarshalBinary() ([]byte, error) {
+	e := codec.NewEncoder()
+	e.Uint32(codec.FieldNetworkID, p.NetworkID)
+	e.Uint64(codec.FieldBaseFee, p.BaseFee)
+	e.Uint64(codec.FieldBaseReserve, p.BaseReserve)
+	e.Uint64(codec.FieldOwnerReserve, p.OwnerReserve)
+	e.StringArray(codec.FieldUNL, p.UNL)
+	e.StringArray(codec.FieldKYCProviders, p.KYCProviders)
+	return e.Bytes()
+}
+
+// UnmarshalBinary decodes the parameters from their canonical encoding
+func (p *Params) UnmarshalBinary(data []byte) error {
+	*p = Params{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldNetworkID:
+			p.NetworkID = d.Uint32()
+		case codec.FieldBaseFee:
+			p.BaseFee = d.Uint64()
+		case codec.FieldBaseReserve:
+			p.BaseReserve = d.Uint64()
+		case codec.FieldOwnerReserve:
+			p.OwnerReserve = d.Uint64()
+		case codec.FieldUNL:
+			p.UNL = d.StringArray()
+		case codec.FieldKYCProviders:
+			p.KYCProviders = d.StringArray()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+// Params loads the network parameters
+func (s *State) Params() (*Params, error) {
+	data, ok := s.tree.Get(block.ParamsKey())
+	if !ok {
+		return nil, ErrParamsNotFound
+	}
+	var params Params
+	if err := params.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
+// SetParams stores the network parameters
+func (s *State) SetParams(params *Params) error {
+	data, err := params.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	s.tree.Set(block.ParamsKey(), data)
+	return nil
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package state
+
+import (
+	"errors"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+)
+
+var ErrAccountNotFound = errors.New("state: account not found")
+
+// State is a mutable view of the ledger objects stored in a block's state
+// tree. It works on its own copy of the tree, so changes only reach a block
+// once they are written back with Tree.
+type State struct {
+	tree *block.SHAMap
+}
+
+// New returns a state working on a copy of tree
+func New(tree *block.SHAMap) *State {
+	return &State{tree: tree.Copy()}
+}
+
+// Tree returns a copy of the state tree with every change made so far
+func (s *State) Tree() *block.SHAMap {
+	return s.tree.Copy()
+}
+
+// Snapshot returns an independent copy of the state, used to apply changes
+// that may have to be thrown away
+func (s *State) Snapshot() *State {
+	return &State{tree: s.tree.Copy()}
+}
+
+// Restore discards every change made since snapshot was taken
+func (s *State) Restore(snapshot *State) {
+	s.tree = snapshot.tree.Copy()
+}
+
+// Account loads an account, or returns ErrAccountNotFound
+func (s *State) Account(accountID string) (*account.Account, error) {
+	data, ok := s.tree.Get(block.AccountKey(accountID))
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+	var acc account.Account
+	if err := acc.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+// HasAccount reports whether the account exists
+func (s *State) HasAccount(accountID string) bool {
+	return s.tree.Has(block.AccountKey(accountID))
+}
+
+// SetAccount creates or updates an account
+func (s *State) SetAccount(acc *account.Account) error {
+	data, err := acc.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	s.tree.Set(block.AccountKey(acc.AccountID), data)
+	return nil
+}
+
+// DeleteAccount removes an account
+func (s *State) DeleteAccount(accountID string) error {
+	err := s.tree.Delete(block.AccountKey(accountID))
+	if errors.Is(err, block.ErrKeyNotFound) {
+		return ErrAccountNotFound
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package storage
+
+import "errors"
+
+var (
+	ErrNotFound = errors.New("storage: not found")
+	ErrClosed   = errors.New("storage: database closed")
+)
+
+// Database is the key-value store the ledger is persisted in. Backends only
+// need to provide atomic batches: either every write of a batch is visible
+// after Write returns, or none is, even if the process crashes midway.
+type Database interface {
+	// Get returns the value stored under key, or ErrNotFound
+	Get(key []byte) ([]byte, error)
+
+	// Has reports whether a value is stored under key
+	Has(key []byte) (bool, error)
+
+	// NewBatch starts a set of writes applied atomically by Batch.Write
+	NewBatch() Batch
+
+	// Close releases the resources held by the database
+	Close() error
+}
+
+// Batch collects writes until they are committed together
+type Batch interface {
+	Put(key, value []byte)
+	Delete(key []byte)
+
+	// Write commits the batch. A batch must not be reused after Write.
+	Write() error
+}
+
+// batchOp is a single write held by a batch
+type batchOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
@@ -0,0 +1,447 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package storage
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// recordHeaderSize is the size of the length and checksums preceding each
+	// record: the payload length, the payload checksum and the checksum of
+	// the first two
+	recordHeaderSize = 12
+
+	// maxRecordSize guards against allocating huge buffers for a corrupted length
+	maxRecordSize = 1 << 30
+
+	opPut    byte = 1
+	opDelete byte = 2
+)
+
+var (
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	// compactRecordSize is the payload size at which Compact starts a new
+	// record, so that every record it writes stays under maxRecordSize
+	compactRecordSize = maxRecordSize / 2
+
+	errCorruptRecord = errors.New("storage: corrupt record")
+)
+
+// FileDB is an embedded Database kept in a single append-only file.
+//
+// Every batch is appended as one record (payload length, CRC32 checksums of
+// the payload and of the header, and the list of writes) and synced to disk
+// before Write returns. When the file is opened the records are replayed in
+// order; a last record cut short by a crash fails its checksum and is
+// truncated away, so a batch is either fully applied or not at all. The
+// header checksum makes the length of every record trustworthy, so a bad
+// record followed by more data, or a bad header anywhere, is corruption
+// rather than a torn write and fails the open. Only value offsets are kept in
+// memory.
+type FileDB struct {
+	path  string
+	file  *os.File
+	index map[string]valueRef
+	size  int64
+	mutex sync.RWMutex
+}
+
+// valueRef locates a value inside the file
+type valueRef struct {
+	offset int64
+	length int
+}
+
+// OpenFileDB opens the database at path, creating it if needed
+func OpenFileDB(path string) (*FileDB, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &FileDB{path: path, file: file, index: make(map[string]valueRef)}
+	if err := db.replay(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// replay rebuilds the index from the records in the file and drops a torn tail
+func (db *FileDB) replay() error {
+	info, err := db.file.Stat()
+	if err != nil {
+		return err
+	}
+	reader := bufio.NewReader(io.NewSectionReader(db.file, 0, info.Size()))
+	var offset int64
+
+	for {
+		payload, length, err := readRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if !errors.Is(err, errCorruptRecord) && err != io.ErrUnexpectedEOF {
+				return err
+			}
+
+			// only the last record can be torn by a crash: the file ends
+			// inside it, or its payload did not fully reach the disk. A bad
+			// header, or a bad record with data after it, means the file is
+			// damaged
+			if err != io.ErrUnexpectedEOF && (length < 0 || offset+recordHeaderSize+length < info.Size()) {
+				return fmt.Errorf("%w at offset %d", errCorruptRecord, offset)
+			}
+
+			// the last batch did not reach the disk entirely
+			if err := db.file.Truncate(offset); err != nil {
+				return err
+			}
+			if err := db.file.Sync(); err != nil {
+				return err
+			}
+			break
+		}
+
+		ops, err := decodeOps(payload, offset+recordHeaderSize)
+		if err != nil {
+			return err
+		}
+		db.apply(ops)
+		offset += recordHeaderSize + length
+	}
+
+	db.size = offset
+	return nil
+}
+
+// readRecord reads the next record and returns its payload and the payload
+// length given in its header. The length is also set when the payload is
+// corrupt, and is -1 when the header itself is
+func readRecord(r io.Reader) ([]byte, int64, error) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, 0, err
+	}
+	if crc32.Checksum(header[:8], crcTable) != binary.BigEndian.Uint32(header[8:]) {
+		return nil, -1, errCorruptRecord
+	}
+
+	length := int64(binary.BigEndian.Uint32(header[:4]))
+	if length > maxRecordSize {
+		return nil, -1, errCorruptRecord
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if err == io.EOF {
+			return nil, length, io.ErrUnexpectedEOF
+		}
+		return nil, length, err
+	}
+	if crc32.Checksum(payload, crcTable) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, length, errCorruptRecord
+	}
+	return payload, length, nil
+}
+
+// fileOp is a decoded write with the position of its value in the file
+type fileOp struct {
+	key    string
+	ref    valueRef
+	delete bool
+}
+
+// encodeOps serializes the writes of a batch as a record payload
+func encodeOps(ops []batchOp) []byte {
+	var payload []byte
+	for _, op := range ops {
+		if op.delete {
+			payload = append(payload, opDelete)
+			payload = binary.AppendUvarint(payload, uint64(len(op.key)))
+			payload = append(payload, op.key...)
+			continue
+		}
+		payload = append(payload, opPut)
+		payload = binary.AppendUvarint(payload, uint64(len(op.key)))
+		payload = append(payload, op.key...)
+		payload = binary.AppendUvarint(payload, uint64(len(op.value)))
+		payload = append(payload, op.value...)
+	}
+	return payload
+}
+
+// decodeOps parses a record payload stored at base in the file
+func decodeOps(payload []byte, base int64) ([]fileOp, error) {
+	var ops []fileOp
+	readBytes := func(pos int) ([]byte, int, error) {
+		n, size := binary.Uvarint(payload[pos:])
+		if size <= 0 || uint64(len(payload)-pos-size) < n {
+			return nil, 0, errCorruptRecord
+		}
+		start := pos + size
+		return payload[start : start+int(n)], start + int(n), nil
+	}
+
+	for pos := 0; pos < len(payload); {
+		kind := payload[pos]
+		key, next, err := readBytes(pos + 1)
+		if err != nil {
+			return nil, err
+		}
+		switch kind {
+		case opDelete:
+			ops = append(ops, fileOp{key: string(key), delete: true})
+			pos = next
+		case opPut:
+			value, end, err := readBytes(next)
+			if err != nil {
+				return nil, err
+			}
+			ref := valueRef{offset: base + int64(end-len(value)), length: len(value)}
+			ops = append(ops, fileOp{key: string(key), ref: ref})
+			pos = end
+		default:
+			return nil, fmt.Errorf("%w: unknown op %d", errCorruptRecord, kind)
+		}
+	}
+	return ops, nil
+}
+
+func (db *FileDB) apply(ops []fileOp) {
+	for _, op := range ops {
+		if op.delete {
+			delete(db.index, op.key)
+		} else {
+			db.index[op.key] = op.ref
+		}
+	}
+}
+
+func (db *FileDB) Get(key []byte) ([]byte, error) {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	if db.file == nil {
+		return nil, ErrClosed
+	}
+	ref, ok := db.index[string(key)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	value := make([]byte, ref.length)
+	if _, err := db.file.ReadAt(value, ref.offset); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (db *FileDB) Has(key []byte) (bool, error) {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	if db.file == nil {
+		return false, ErrClosed
+	}
+	_, ok := db.index[string(key)]
+	return ok, nil
+}
+
+func (db *FileDB) NewBatch() Batch {
+	return &fileBatch{db: db}
+}
+
+// Compact rewrites the file with only the live values, dropping overwritten
+// and deleted ones. Values are split over records of at most
+// compactRecordSize bytes. The new file replaces the old one with an atomic
+// rename.
+func (db *FileDB) Compact() error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if db.file == nil {
+		return ErrClosed
+	}
+
+	tmpPath := db.path + ".compact"
+	tmp, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	index, size, err := db.writeLive(tmp)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, db.path)
+	}
+	if err == nil {
+		err = syncDir(filepath.Dir(db.path))
+	}
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+
+	db.file.Close()
+	db.file = tmp
+	db.index = index
+	db.size = size
+	return nil
+}
+
+// writeLive writes the live values to w as records and returns their index
+// in the new file and the size of the file
+func (db *FileDB) writeLive(w io.Writer) (map[string]valueRef, int64, error) {
+	index := make(map[string]valueRef, len(db.index))
+	var size int64
+	var ops []batchOp
+	var pending int
+
+	flush := func() error {
+		if len(ops) == 0 {
+			return nil
+		}
+		payload := encodeOps(ops)
+		if _, err := w.Write(recordHeader(payload)); err != nil {
+			return err
+		}
+		if _, err := w.Write(payload); err != nil {
+			return err
+		}
+		decoded, err := decodeOps(payload, size+recordHeaderSize)
+		if err != nil {
+			return err
+		}
+		for _, op := range decoded {
+			index[op.key] = op.ref
+		}
+		size += recordHeaderSize + int64(len(payload))
+		ops, pending = ops[:0], 0
+		return nil
+	}
+
+	for key, ref := range db.index {
+		// a value fits in a record on its own, since every batch did
+		if pending > 0 && pending+len(key)+ref.length > compactRecordSize {
+			if err := flush(); err != nil {
+				return nil, 0, err
+			}
+		}
+		value := make([]byte, ref.length)
+		if _, err := db.file.ReadAt(value, ref.offset); err != nil {
+			return nil, 0, err
+		}
+		ops = append(ops, batchOp{key: []byte(key), value: value})
+		pending += len(key) + len(value) + 2*binary.MaxVarintLen64 + 1
+	}
+	if err := flush(); err != nil {
+		return nil, 0, err
+	}
+	return index, size, nil
+}
+
+// syncDir flushes the directory entry of a renamed file to disk
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+func (db *FileDB) Close() error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if db.file == nil {
+		return nil
+	}
+	err := db.file.Close()
+	db.file = nil
+	return err
+}
+
+func recordHeader(payload []byte) []byte {
+	header := make([]byte, recordHeaderSize)
+	binary.BigEndian.PutUint32(header[:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(header[4:8], crc32.Checksum(payload, crcTable))
+	binary.BigEndian.PutUint32(header[8:], crc32.Checksum(header[:8], crcTable))
+	return header
+}
+
+type fileBatch struct {
+	db  *FileDB
+	ops []batchOp
+}
+
+func (b *fileBatch) Put(key, value []byte) {
+	b.ops = append(b.ops, batchOp{key: append([]byte(nil), key...), value: append([]byte(nil), value...)})
+}
+
+func (b *fileBatch) Delete(key []byte) {
+	b.ops = append(b.ops, batchOp{key: append([]byte(nil), key...), delete: true})
+}
+
+func (b *fileBatch) Write() error {
+	if len(b.ops) == 0 {
+		return nil
+	}
+
+	payload := encodeOps(b.ops)
+	if len(payload) > maxRecordSize {
+		return fmt.Errorf("storage: batch of %d bytes is too large", len(payload))
+	}
+	record := append(recordHeader(payload), payload...)
+
+	db := b.db
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	if db.file == nil {
+		return ErrClosed
+	}
+	if _, err := db.file.WriteAt(record, db.size); err != nil {
+		// drop whatever part of the record was written
+		_ = db.file.Truncate(db.size)
+		return err
+	}
+	if err := db.file.Sync(); err != nil {
+		return err
+	}
+
+	ops, err := decodeOps(payload, db.size+recordHeaderSize)
+	if err != nil {
+		return err
+	}
+	db.apply(ops)
+	db.size += int64(len(record))
+	return nil
+}
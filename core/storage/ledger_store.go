@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+)
+
+// Key prefixes of the records written by LedgerStore
+var (
+	headerPrefix = []byte("h") // h + block hash -> block header
+	indexPrefix  = []byte("i") // i + block index (big endian) -> block hash
+	nodePrefix   = []byte("n") // n + node hash -> serialized SHAMap node
+	txPrefix     = []byte("t") // t + tx key -> block index + serialized transaction
+	latestKey    = []byte("latest")
+	validatedKey = []byte("validated")
+)
+
+var ErrMissingHash = errors.New("storage: block has no hash")
+
+// LedgerStore persists blocks, the nodes of their state and transaction
+// trees, and the transactions themselves on top of a Database
+type LedgerStore struct {
+	db Database
+}
+
+func NewLedgerStore(db Database) *LedgerStore {
+	return &LedgerStore{db: db}
+}
+
+// Open returns a LedgerStore backed by a FileDB at path, or by a MemoryDB if
+// path is empty
+func Open(path string) (*LedgerStore, error) {
+	if path == "" {
+		return NewLedgerStore(NewMemoryDB()), nil
+	}
+	db, err := OpenFileDB(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewLedgerStore(db), nil
+}
+
+// Close closes the underlying database
+func (s *LedgerStore) Close() error {
+	return s.db.Close()
+}
+
+func prefixed(prefix, key []byte) []byte {
+	return append(append(make([]byte, 0, len(prefix)+len(key)), prefix...), key...)
+}
+
+func indexKey(index uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], index)
+	return prefixed(indexPrefix, b[:])
+}
+
+// WriteBlock stores the block and makes it the latest one. Everything is
+// written in a single batch, so after a crash either the whole block is
+// stored or the previous latest block is still intact.
+func (s *LedgerStore) WriteBlock(b *block.Block) error {
+	if len(b.Header.Hash) == 0 {
+		return ErrMissingHash
+	}
+
+	header, err := b.Header.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	batch.Put(prefixed(headerPrefix, b.Header.Hash), header)
+	batch.Put(indexKey(b.Header.Index), b.Header.Hash)
+
+	if err := s.writeNodes(batch, &b.Accounts); err != nil {
+		return err
+	}
+	if err := s.writeNodes(batch, &b.Transactions); err != nil {
+		return err
+	}
+
+	var index [8]byte
+	binary.BigEndian.PutUint64(index[:], b.Header.Index)
+	err = b.Transactions.Walk(func(key block.Key, data []byte) error {
+		batch.Put(prefixed(txPrefix, key[:]), append(index[:], data...))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	batch.Put(latestKey, b.Header.Hash)
+	return batch.Write()
+}
+
+// writeNodes adds the tree nodes missing from the database to the batch.
+// Nodes are content addressed, so a stored inner node means its whole
+// subtree is stored as well.
+func (s *LedgerStore) writeNodes(batch Batch, m *block.SHAMap) error {
+	var err error
+	skip := func(hash []byte) bool {
+		has, hasErr := s.db.Has(prefixed(nodePrefix, hash))
+		if hasErr != nil {
+			err = hasErr
+		}
+		return has
+	}
+	walkErr := m.WalkNodes(skip, func(hash, blob []byte) error {
+		batch.Put(prefixed(nodePrefix, hash), blob)
+		return nil
+	})
+	if walkErr != nil {
+		return walkErr
+	}
+	return err
+}
+
+// ReadNode returns a serialized tree node by hash
+func (s *LedgerStore) ReadNode(hash []byte) ([]byte, error) {
+	return s.db.Get(prefixed(nodePrefix, hash))
+}
+
+// ReadHeader returns the header of the block with the given hash
+func (s *LedgerStore) ReadHeader(hash []byte) (*block.BlockHeader, error) {
+	data, err := s.db.Get(prefixed(headerPrefix, hash))
+	if err != nil {
+		return nil, err
+	}
+	var header block.BlockHeader
+	if err := header.UnmarshalBinary(data); err != nil {
+		return nil, fmt.Errorf("storage: corrupt header %x: %v", hash, err)
+	}
+	return &header, nil
+}
+
+// ReadBlockByHash loads a block and its trees by block hash
+func (s *LedgerStore) ReadBlockByHash(hash []byte) (*block.Block, error) {
+	header, err := s.ReadHeader(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := block.LoadSHAMap(header.StateHash, s.ReadNode)
+	if err != nil {
+		return nil, fmt.Errorf("storage: load state tree of block %d: %w", header.Index, err)
+	}
+	txs, err := block.LoadSHAMap(header.TxHash, s.ReadNode)
+	if err != nil {
+		return nil, fmt.Errorf("storage: load transaction tree of block %d: %w", header.Index, err)
+	}
+
+	return &block.Block{Header: *header, Accounts: *accounts, Transactions: *txs}, nil
+}
+
+// ReadBlockByIndex loads a block and its trees by block index
+func (s *LedgerStore) ReadBlockByIndex(index uint64) (*block.Block, error) {
+	hash, err := s.db.Get(indexKey(index))
+	if err != nil {
+		return nil, err
+	}
+	return s.ReadBlockByHash(hash)
+}
+
+// ReadLatestBlock loads the block most recently written. It returns
+// ErrNotFound if the store is empty.
+func (s *LedgerStore) ReadLatestBlock() (*block.Block, error) {
+	hash, err := s.db.Get(latestKey)
+	if err != nil {
+		return nil, err
+	}
+	return s.ReadBlockByHash(hash)
+}
+
+// WriteValidated records the block with the given hash, which must already
+// be stored, as the last fully validated block
+func (s *LedgerStore) WriteValidated(hash []byte) error {
+	if len(hash) == 0 {
+		return ErrMissingHash
+	}
+	batch := s.db.NewBatch()
+	batch.Put(validatedKey, hash)
+	return batch.Write()
+}
+
+// ReadValidatedBlock loads the last fully validated block. It returns
+// ErrNotFound if no block has been validated yet.
+func (s *LedgerStore) ReadValidatedBlock() (*block.Block, error) {
+	hash, err := s.db.Get(validatedKey)
+	if err != nil {
+		return nil, err
+	}
+	return s.ReadBlockByHash(hash)
+}
+
+// ReadTransaction returns a serialized transaction and the index of the
+// block it was included in
+func (s *LedgerStore) ReadTransaction(key block.Key) ([]byte, uint64, error) {
+	data, err := s.db.Get(prefixed(txPrefix, key[:]))
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(data) < 8 {
+		return nil, 0, fmt.Errorf("storage: corrupt transaction %v", key)
+	}
+	return data[8:], binary.BigEndian.Uint64(data[:8]), nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package storage
+
+import "sync"
+
+// MemoryDB is a Database kept entirely in memory, meant for tests
+type MemoryDB struct {
+	data   map[string][]byte
+	closed bool
+	mutex  sync.RWMutex
+}
+
+// NewMemoryDB returns an empty in-memory database
+func NewMemoryDB() *MemoryDB {
+	return &MemoryDB{data: make(map[string][]byte)}
+}
+
+func (db *MemoryDB) Get(key []byte) ([]byte, error) {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	if db.closed {
+		return nil, ErrClosed
+	}
+	value, ok := db.data[string(key)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return append([]byte(nil), value...), nil
+}
+
+func (db *MemoryDB) Has(key []byte) (bool, error) {
+	db.mutex.RLock()
+	defer db.mutex.RUnlock()
+
+	if db.closed {
+		return false, ErrClosed
+	}
+	_, ok := db.data[string(key)]
+	return ok, nil
+}
+
+func (db *MemoryDB) NewBatch() Batch {
+	return &memoryBatch{db: db}
+}
+
+func (db *MemoryDB) Close() error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	db.closed = true
+	return nil
+}
+
+type memoryBatch struct {
+	db  *MemoryDB
+	ops []batchOp
+}
+
+func (b *memoryBatch) Put(key, value []byte) {
+	b.ops = append(b.ops, batchOp{key: append([]byte(nil), key...), value: append([]byte(nil), value...)})
+}
+
+func (b *memoryBatch) Delete(key []byte) {
+	b.ops = append(b.ops, batchOp{key: append([]byte(nil), key...), delete: true})
+}
+
+func (b *memoryBatch) Write() error {
+	b.db.mutex.Lock()
+	defer b.db.mutex.Unlock()
+
+	if b.db.closed {
+		return ErrClosed
+	}
+	for _, op := range b.ops {
+		if op.delete {
+			delete(b.db.data, string(op.key))
+		} else {
+			b.db.data[string(op.key)] = op.value
+		}
+	}
+	return nil
+}
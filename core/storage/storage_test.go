@@ -0,0 +1,301 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+)
+
+func testDatabase(t *testing.T, db Database) {
+	batch := db.NewBatch()
+	batch.Put([]byte("a"), []byte("1"))
+	batch.Put([]byte("b"), []byte("2"))
+	batch.Put([]byte("a"), []byte("3"))
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+
+	if value, err := db.Get([]byte("a")); err != nil || string(value) != "3" {
+		t.Fatalf("got %q %v, want 3", value, err)
+	}
+
+	batch = db.NewBatch()
+	batch.Delete([]byte("b"))
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Get([]byte("b")); err != ErrNotFound {
+		t.Fatalf("deleted key: got %v", err)
+	}
+	if has, err := db.Has([]byte("a")); err != nil || !has {
+		t.Fatalf("has: got %v %v", has, err)
+	}
+}
+
+func TestMemoryDB(t *testing.T) {
+	testDatabase(t, NewMemoryDB())
+}
+
+func TestFileDB(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ledger.db")
+	db, err := OpenFileDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testDatabase(t, db)
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err = OpenFileDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if value, err := db.Get([]byte("a")); err != nil || string(value) != "3" {
+		t.Fatalf("after reopen: got %q %v", value, err)
+	}
+	if _, err := db.Get([]byte("b")); err != ErrNotFound {
+		t.Fatalf("after reopen, deleted key: got %v", err)
+	}
+
+	if err := db.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := db.Get([]byte("a")); err != nil || string(value) != "3" {
+		t.Fatalf("after compact: got %q %v", value, err)
+	}
+}
+
+func TestFileDBTornWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ledger.db")
+	db, err := OpenFileDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := db.NewBatch()
+	batch.Put([]byte("a"), []byte("1"))
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+	batch = db.NewBatch()
+	batch.Put([]byte("a"), []byte("2"))
+	batch.Put([]byte("b"), bytes.Repeat([]byte{1}, 100))
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	// simulate a crash in the middle of the second batch
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(path, info.Size()-10); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err = OpenFileDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if value, err := db.Get([]byte("a")); err != nil || string(value) != "1" {
+		t.Fatalf("got %q %v, want the first batch only", value, err)
+	}
+	if _, err := db.Get([]byte("b")); err != ErrNotFound {
+		t.Fatalf("partial batch is visible: %v", err)
+	}
+
+	// the store keeps working after the torn record was dropped
+	batch = db.NewBatch()
+	batch.Put([]byte("c"), []byte("3"))
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileDBCorruptRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ledger.db")
+	db, err := OpenFileDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"a", "b"} {
+		batch := db.NewBatch()
+		batch.Put([]byte(key), []byte("value"))
+		if err := batch.Write(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	// damage the first record: the valid record after it must not be dropped
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[recordHeaderSize+2] ^= 0xff
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := OpenFileDB(path); !errors.Is(err, errCorruptRecord) {
+		t.Fatalf("got %v, want a corrupt record error", err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Size() != int64(len(data)) {
+		t.Fatal("corrupt file was truncated")
+	}
+}
+
+func TestFileDBCorruptLength(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ledger.db")
+	db, err := OpenFileDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"a", "b", "c"} {
+		batch := db.NewBatch()
+		batch.Put([]byte(key), []byte("value"))
+		if err := batch.Write(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	// a flipped bit makes the length of the middle record run past the end
+	// of the file, which must not look like a torn last record
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second := len(data) / 3
+	data[second+2] ^= 0x01
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := OpenFileDB(path); !errors.Is(err, errCorruptRecord) {
+		t.Fatalf("got %v, want a corrupt record error", err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Size() != int64(len(data)) {
+		t.Fatal("records after the corrupt one were truncated")
+	}
+}
+
+func TestFileDBCompactRecords(t *testing.T) {
+	defer func(size int) { compactRecordSize = size }(compactRecordSize)
+	compactRecordSize = 64
+
+	path := filepath.Join(t.TempDir(), "ledger.db")
+	db, err := OpenFileDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := db.NewBatch()
+	for i := 0; i < 20; i++ {
+		batch.Put([]byte{byte(i)}, bytes.Repeat([]byte{byte(i)}, 20))
+	}
+	if err := batch.Write(); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	db, err = OpenFileDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for i := 0; i < 20; i++ {
+		value, err := db.Get([]byte{byte(i)})
+		if err != nil || !bytes.Equal(value, bytes.Repeat([]byte{byte(i)}, 20)) {
+			t.Fatalf("key %d after compact: got %x %v", i, value, err)
+		}
+	}
+}
+
+func TestLedgerStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ledger.db")
+	store, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var blocks []*block.Block
+	for i := uint64(0); i < 3; i++ {
+		b := block.NewBlock(i, nil, 1000)
+		for j := uint64(0); j <= i; j++ {
+			b.Accounts.Set(block.AccountKey(string(rune('a'+j))), []byte{byte(i)})
+		}
+		b.Transactions.Set(block.TransactionKey([]byte{byte(i)}), []byte{byte(i)})
+		b.UpdateRoots()
+		b.Header.Hash = []byte{byte(i), 0xee}
+		if err := store.WriteBlock(b); err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, b)
+	}
+	if _, err := store.ReadValidatedBlock(); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("validated block before any validation: got %v", err)
+	}
+	if err := store.WriteValidated(blocks[1].Header.Hash); err != nil {
+		t.Fatal(err)
+	}
+	store.Close()
+
+	store, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	latest, err := store.ReadLatestBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest.Header.Index != 2 || !bytes.Equal(latest.Accounts.RootHash(), blocks[2].Header.StateHash) {
+		t.Fatalf("latest block %d does not match", latest.Header.Index)
+	}
+
+	validated, err := store.ReadValidatedBlock()
+	if err != nil || validated.Header.Index != 1 {
+		t.Fatalf("got validated block %v, err %v", validated, err)
+	}
+
+	first, err := store.ReadBlockByIndex(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Accounts.Len() != 1 || !bytes.Equal(first.Transactions.RootHash(), blocks[0].Header.TxHash) {
+		t.Fatal("block 0 does not match")
+	}
+
+	blob, index, err := store.ReadTransaction(block.TransactionKey([]byte{1}))
+	if err != nil || index != 1 || !bytes.Equal(blob, []byte{1}) {
+		t.Fatalf("got tx %v in block %d, err %v", blob, index, err)
+	}
+}
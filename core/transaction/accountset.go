@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transaction
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// Settings that AccountSet removes from the account, combined in Clear
+const (
+	ClearDomain uint32 = 1 << iota
+	ClearMessageKey
+	ClearTransferRate
+)
+
+// AccountSet changes the flags and settings of the sending account. SetFlags
+// and ClearFlags are masks of account flags. Domain, MessageKey and
+// TransferRate replace the current setting when they are not empty.
+type AccountSet struct {
+	BaseTransaction
+	SetFlags     uint32 `json:"set_flags"`
+	ClearFlags   uint32 `json:"clear_flags"`
+	Domain       string `json:"domain"`
+	MessageKey   string `json:"message_key"`
+	TransferRate uint32 `json:"transfer_rate"`
+	Clear        uint32 `json:"clear"`
+}
+
+func (t *AccountSet) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *AccountSet) GetAccount() string {
+	return t.Account
+}
+
+func (t *AccountSet) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *AccountSet) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *AccountSet) Serialize() ([]byte, error) {
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Uint32(codec.FieldSetFlags, t.SetFlags)
+	e.Uint32(codec.FieldClearFlags, t.ClearFlags)
+	e.Uint32(codec.FieldTransferRate, t.TransferRate)
+	e.Uint32(codec.FieldClear, t.Clear)
+	e.String(codec.FieldDomain, t.Domain)
+	e.String(codec.FieldMessageKey, t.MessageKey)
+	return e.Bytes()
+}
+
+func (t *AccountSet) Deserialize(data []byte) error {
+	*t = AccountSet{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldSetFlags:
+			t.SetFlags = d.Uint32()
+		case codec.FieldClearFlags:
+			t.ClearFlags = d.Uint32()
+		case codec.FieldTransferRate:
+			t.TransferRate = d.Uint32()
+		case codec.FieldClear:
+			t.Clear = d.Uint32()
+		case codec.FieldDomain:
+			t.Domain = d.String()
+		case codec.FieldMessageKey:
+			t.MessageKey = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transaction
+
+import (
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// EscrowCreate takes Amount from the account and holds it for Destination.
+// Condition, when set, is the SHA-256 hash of the preimage the finisher must
+// supply.
+type EscrowCreate struct {
+	BaseTransaction
+	Destination    string    `json:"destination"`
+	DestinationTag uint32    `json:"destination_tag"`
+	Amount         Amount    `json:"amount"`
+	FinishAfter    time.Time `json:"finish_after"`
+	CancelAfter    time.Time `json:"cancel_after"`
+	Condition      []byte    `json:"condition"`
+}
+
+func (t *EscrowCreate) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *EscrowCreate) GetAccount() string {
+	return t.Account
+}
+
+func (t *EscrowCreate) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *EscrowCreate) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *EscrowCreate) Serialize() ([]byte, error) {
+	amount, err := t.Amount.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Uint32(codec.FieldDestinationTag, t.DestinationTag)
+	e.Time(codec.FieldFinishAfter, t.FinishAfter)
+	e.Time(codec.FieldCancelAfter, t.CancelAfter)
+	e.Blob(codec.FieldCondition, t.Condition)
+	e.String(codec.FieldDestination, t.Destination)
+	e.Object(codec.FieldAmount, amount)
+	return e.Bytes()
+}
+
+func (t *EscrowCreate) Deserialize(data []byte) error {
+	*t = EscrowCreate{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldDestinationTag:
+			t.DestinationTag = d.Uint32()
+		case codec.FieldFinishAfter:
+			t.FinishAfter = d.Time()
+		case codec.FieldCancelAfter:
+			t.CancelAfter = d.Time()
+		case codec.FieldCondition:
+			t.Condition = d.Blob()
+		case codec.FieldDestination:
+			t.Destination = d.String()
+		case codec.FieldAmount:
+			if err := t.Amount.UnmarshalBinary(d.Object()); err != nil {
+				return err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+// EscrowFinish releases the escrow that Owner created with EscrowSequence to
+// its destination. Fulfillment is the preimage of the escrow's condition.
+type EscrowFinish struct {
+	BaseTransaction
+	Owner          string `json:"owner"`
+	EscrowSequence uint64 `json:"escrow_sequence"`
+	Fulfillment    []byte `json:"fulfillment"`
+}
+
+func (t *EscrowFinish) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *EscrowFinish) GetAccount() string {
+	return t.Account
+}
+
+func (t *EscrowFinish) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *EscrowFinish) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *EscrowFinish) Serialize() ([]byte, error) {
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Uint64(codec.FieldEscrowSequence, t.EscrowSequence)
+	e.Blob(codec.FieldFulfillment, t.Fulfillment)
+	e.String(codec.FieldOwner, t.Owner)
+	return e.Bytes()
+}
+
+func (t *EscrowFinish) Deserialize(data []byte) error {
+	*t = EscrowFinish{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldEscrowSequence:
+			t.EscrowSequence = d.Uint64()
+		case codec.FieldFulfillment:
+			t.Fulfillment = d.Blob()
+		case codec.FieldOwner:
+			t.Owner = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+// EscrowCancel returns the escrow that Owner created with EscrowSequence to
+// its owner
+type EscrowCancel struct {
+	BaseTransaction
+	Owner          string `json:"owner"`
+	EscrowSequence uint64 `json:"escrow_sequence"`
+}
+
+func (t *EscrowCancel) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *EscrowCancel) GetAccount() string {
+	return t.Account
+}
+
+func (t *EscrowCancel) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *EscrowCancel) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *EscrowCancel) Serialize() ([]byte, error) {
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Uint64(codec.FieldEscrowSequence, t.EscrowSequence)
+	e.String(codec.FieldOwner, t.Owner)
+	return e.Bytes()
+}
+
+func (t *EscrowCancel) Deserialize(data []byte) error {
+	*t = EscrowCancel{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldEscrowSequence:
+			t.EscrowSequence = d.Uint64()
+		case codec.FieldOwner:
+			t.Owner = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transaction
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/block/account/kyc"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// KYCSet records the KYC of the sending account. KYCHash is the hash of the
+// account's KYC data, and KYCSignature is the attestation of that hash for
+// the account by the provider whose hex encoded public key is KYCProvider.
+// KYCData is optional: when set it must hash to KYCHash, but it is not
+// stored in the ledger.
+type KYCSet struct {
+	BaseTransaction
+	KYCData      kyc.KYCData `json:"kyc_data"`
+	KYCHash      []byte      `json:"kyc_hash"`
+	KYCSignature []byte      `json:"kyc_signature"`
+	KYCProvider  string      `json:"kyc_provider"`
+}
+
+func (t *KYCSet) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *KYCSet) GetAccount() string {
+	return t.Account
+}
+
+func (t *KYCSet) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *KYCSet) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *KYCSet) Serialize() ([]byte, error) {
+	kycData, err := t.KYCData.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Blob(codec.FieldKYCHash, t.KYCHash)
+	e.Blob(codec.FieldKYCSignature, t.KYCSignature)
+	e.String(codec.FieldKYCProvider, t.KYCProvider)
+	e.Object(codec.FieldKYCData, kycData)
+	return e.Bytes()
+}
+
+func (t *KYCSet) Deserialize(data []byte) error {
+	*t = KYCSet{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldKYCHash:
+			t.KYCHash = d.Blob()
+		case codec.FieldKYCSignature:
+			t.KYCSignature = d.Blob()
+		case codec.FieldKYCProvider:
+			t.KYCProvider = d.String()
+		case codec.FieldKYCData:
+			if err := t.KYCData.UnmarshalBinary(d.Object()); err != nil {
+				return err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
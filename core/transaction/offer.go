@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transaction
+
+import (
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// OfferCreate places an offer to give TakerGets in exchange for TakerPays.
+// It first crosses the offers already on the opposite book; whatever is left
+// stays on the book under the sequence of the transaction.
+type OfferCreate struct {
+	BaseTransaction
+	TakerPays Amount    `json:"taker_pays"`
+	TakerGets Amount    `json:"taker_gets"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (t *OfferCreate) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *OfferCreate) GetAccount() string {
+	return t.Account
+}
+
+func (t *OfferCreate) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *OfferCreate) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *OfferCreate) Serialize() ([]byte, error) {
+	pays, err := t.TakerPays.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	gets, err := t.TakerGets.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Time(codec.FieldExpiresAt, t.ExpiresAt)
+	e.Object(codec.FieldTakerPays, pays)
+	e.Object(codec.FieldTakerGets, gets)
+	return e.Bytes()
+}
+
+func (t *OfferCreate) Deserialize(data []byte) error {
+	*t = OfferCreate{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldExpiresAt:
+			t.ExpiresAt = d.Time()
+		case codec.FieldTakerPays:
+			if err := t.TakerPays.UnmarshalBinary(d.Object()); err != nil {
+				return err
+			}
+		case codec.FieldTakerGets:
+			if err := t.TakerGets.UnmarshalBinary(d.Object()); err != nil {
+				return err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+// OfferCancel removes the offer the account placed with OfferSequence
+type OfferCancel struct {
+	BaseTransaction
+	OfferSequence uint64 `json:"offer_sequence"`
+}
+
+func (t *OfferCancel) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *OfferCancel) GetAccount() string {
+	return t.Account
+}
+
+func (t *OfferCancel) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *OfferCancel) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *OfferCancel) Serialize() ([]byte, error) {
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Uint64(codec.FieldOfferSequence, t.OfferSequence)
+	return e.Bytes()
+}
+
+func (t *OfferCancel) Deserialize(data []byte) error {
+	*t = OfferCancel{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldOfferSequence:
+			t.OfferSequence = d.Uint64()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transaction
+
+import (
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// PaymentChannelCreate takes native Amount from the account into a payment
+// channel toward Destination. PublicKey is the hex key that will sign the
+// claims, and SettleDelay the seconds the owner must give the destination
+// before the channel closes.
+type PaymentChannelCreate struct {
+	BaseTransaction
+	Destination    string    `json:"destination"`
+	DestinationTag uint32    `json:"destination_tag"`
+	Amount         Amount    `json:"amount"`
+	SettleDelay    uint32    `json:"settle_delay"`
+	PublicKey      string    `json:"public_key"`
+	CancelAfter    time.Time `json:"cancel_after"`
+}
+
+func (t *PaymentChannelCreate) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *PaymentChannelCreate) GetAccount() string {
+	return t.Account
+}
+
+func (t *PaymentChannelCreate) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *PaymentChannelCreate) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *PaymentChannelCreate) Serialize() ([]byte, error) {
+	amount, err := t.Amount.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Uint32(codec.FieldDestinationTag, t.DestinationTag)
+	e.Uint32(codec.FieldSettleDelay, t.SettleDelay)
+	e.Time(codec.FieldCancelAfter, t.CancelAfter)
+	e.String(codec.FieldDestination, t.Destination)
+	e.String(codec.FieldPublicKey, t.PublicKey)
+	e.Object(codec.FieldAmount, amount)
+	return e.Bytes()
+}
+
+func (t *PaymentChannelCreate) Deserialize(data []byte) error {
+	*t = PaymentChannelCreate{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldDestinationTag:
+			t.DestinationTag = d.Uint32()
+		case codec.FieldSettleDelay:
+			t.SettleDelay = d.Uint32()
+		case codec.FieldCancelAfter:
+			t.CancelAfter = d.Time()
+		case codec.FieldDestination:
+			t.Destination = d.String()
+		case codec.FieldPublicKey:
+			t.PublicKey = d.String()
+		case codec.FieldAmount:
+			if err := t.Amount.UnmarshalBinary(d.Object()); err != nil {
+				return err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+// PaymentChannelFund adds native Amount to the channel the account created
+// with ChannelSequence. A non zero Expiration, at least the settle delay
+// ahead, replaces the expiration of the channel.
+type PaymentChannelFund struct {
+	BaseTransaction
+	ChannelSequence uint64    `json:"channel_sequence"`
+	Amount          Amount    `json:"amount"`
+	Expiration      time.Time `json:"expiration"`
+}
+
+func (t *PaymentChannelFund) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *PaymentChannelFund) GetAccount() string {
+	return t.Account
+}
+
+func (t *PaymentChannelFund) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *PaymentChannelFund) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *PaymentChannelFund) Serialize() ([]byte, error) {
+	amount, err := t.Amount.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Uint64(codec.FieldChannelSequence, t.ChannelSequence)
+	e.Time(codec.FieldExpiration, t.Expiration)
+	e.Object(codec.FieldAmount, amount)
+	return e.Bytes()
+}
+
+func (t *PaymentChannelFund) Deserialize(data []byte) error {
+	*t = PaymentChannelFund{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldChannelSequence:
+			t.ChannelSequence = d.Uint64()
+		case codec.FieldExpiration:
+			t.Expiration = d.Time()
+		case codec.FieldAmount:
+			if err := t.Amount.UnmarshalBinary(d.Object()); err != nil {
+				return err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+// PaymentChannelClaim acts on the channel Owner created with ChannelSequence.
+// A non zero Balance pays the destination up to that total; the destination
+// must then carry ClaimSignature, the owner's signature of a claim for
+// Amount. Close asks to close the channel: at once when sent by the
+// destination or when nothing is left, after the settle delay otherwise.
+type PaymentChannelClaim struct {
+	BaseTransaction
+	Owner           string `json:"owner"`
+	ChannelSequence uint64 `json:"channel_sequence"`
+	Balance         uint64 `json:"balance"`
+	Amount          uint64 `json:"amount"`
+	ClaimSignature  string `json:"claim_signature"`
+	Close           bool   `json:"close"`
+}
+
+func (t *PaymentChannelClaim) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *PaymentChannelClaim) GetAccount() string {
+	return t.Account
+}
+
+func (t *PaymentChannelClaim) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *PaymentChannelClaim) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *PaymentChannelClaim) Serialize() ([]byte, error) {
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Uint64(codec.FieldBalance, t.Balance)
+	e.Uint64(codec.FieldValue, t.Amount)
+	e.Uint64(codec.FieldChannelSequence, t.ChannelSequence)
+	e.Bool(codec.FieldClose, t.Close)
+	e.String(codec.FieldOwner, t.Owner)
+	e.String(codec.FieldClaimSignature, t.ClaimSignature)
+	return e.Bytes()
+}
+
+func (t *PaymentChannelClaim) Deserialize(data []byte) error {
+	*t = PaymentChannelClaim{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldBalance:
+			t.Balance = d.Uint64()
+		case codec.FieldValue:
+			t.Amount = d.Uint64()
+		case codec.FieldChannelSequence:
+			t.ChannelSequence = d.Uint64()
+		case codec.FieldClose:
+			t.Close = d.Bool()
+		case codec.FieldOwner:
+			t.Owner = d.String()
+		case codec.FieldClaimSignature:
+			t.ClaimSignature = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
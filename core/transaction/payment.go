@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transaction
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// Payment transaction
+type Payment struct {
+	BaseTransaction
+	Destination    string `json:"destination"`
+	DestinationTag uint32 `json:"destination_tag"`
+	Amount         Amount `json:"amount"`
+}
+
+func (t *Payment) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *Payment) GetAccount() string {
+	return t.Account
+}
+
+func (t *Payment) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *Payment) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *Payment) Serialize() ([]byte, error) {
+	amount, err := t.Amount.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Uint32(codec.FieldDestinationTag, t.DestinationTag)
+	e.String(codec.FieldDestination, t.Destination)
+	e.Object(codec.FieldAmount, amount)
+	return e.Bytes()
+}
+
+func (t *Payment) Deserialize(data []byte) error {
+	*t = Payment{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldDestinationTag:
+			t.DestinationTag = d.Uint32()
+		case codec.FieldDestination:
+			t.Destination = d.String()
+		case codec.FieldAmount:
+			if err := t.Amount.UnmarshalBinary(d.Object()); err != nil {
+				return err
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transaction
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+	"github.com/ezcon-foundation/go-ezcon/crypto"
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+)
+
+// hashPrefixSign and hashPrefixMultiSign keep transaction signatures apart
+// from each other and from signatures over other kinds of messages
+var (
+	hashPrefixSign      = []byte("STX\x00")
+	hashPrefixMultiSign = []byte("SMT\x00")
+)
+
+// SigningData returns the message an account signs: the canonical encoding
+// of the transaction without its signature, after a fixed prefix
+func SigningData(tx Transaction) ([]byte, error) {
+	data, err := tx.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	unsigned, err := codec.Omit(data, codec.FieldSignature)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte(nil), hashPrefixSign...), unsigned...), nil
+}
+
+// Sign sets the signing public key of the transaction and signs it with priv
+func Sign(tx Transaction, priv crypto.PrivateKey) error {
+	pub, ok := priv.Public().(crypto.PublicKey)
+	if !ok {
+		return crypto.ErrTypeMismatch
+	}
+	pubKey, err := keys.MarshalPublicKey(pub)
+	if err != nil {
+		return err
+	}
+
+	tx.setSignature(hex.EncodeToString(pubKey), "")
+	data, err := SigningData(tx)
+	if err != nil {
+		return err
+	}
+	tx.setSignature(hex.EncodeToString(pubKey), hex.EncodeToString(keys.Sign(priv, data)))
+	return nil
+}
+
+// VerifySignature reports whether the transaction carries a valid signature
+// by its SigningPubKey. It does not tell whether that key may sign for the
+// account.
+func VerifySignature(tx Transaction) bool {
+	pubKey, ok := codec.DecodeHex(tx.GetSigningPubKey())
+	if !ok {
+		return false
+	}
+	sig, ok := codec.DecodeHex(tx.GetSignature())
+	if !ok {
+		return false
+	}
+	data, err := SigningData(tx)
+	if err != nil {
+		return false
+	}
+	return keys.Verify(pubKey, data, sig)
+}
+
+// MultiSigningData returns the message the signer account signs when it
+// multi-signs the transaction: the canonical encoding of the transaction
+// without any signature, after a fixed prefix and followed by the signer
+func MultiSigningData(tx Transaction, signer string) ([]byte, error) {
+	data, err := tx.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	unsigned, err := codec.Omit(data, codec.FieldSignature, codec.FieldSigners)
+	if err != nil {
+		return nil, err
+	}
+	msg := append(append([]byte(nil), hashPrefixMultiSign...), unsigned...)
+	return append(msg, signer...), nil
+}
+
+// MultiSign adds the signature of priv, on behalf of the account it
+// controls, to the signers of the transaction. A previous signature of the
+// same account is replaced, and signers stay sorted by account.
+func MultiSign(tx Transaction, priv crypto.PrivateKey) error {
+	pub, ok := priv.Public().(crypto.PublicKey)
+	if !ok {
+		return crypto.ErrTypeMismatch
+	}
+	pubKey, err := keys.MarshalPublicKey(pub)
+	if err != nil {
+		return err
+	}
+
+	signer := address.FromPublicKey(pubKey)
+	data, err := MultiSigningData(tx, signer)
+	if err != nil {
+		return err
+	}
+	entry := Signer{
+		Account:       signer,
+		SigningPubKey: hex.EncodeToString(pubKey),
+		Signature:     hex.EncodeToString(keys.Sign(priv, data)),
+	}
+
+	var signers []Signer
+	for _, s := range tx.GetSigners() {
+		if s.Account != signer {
+			signers = append(signers, s)
+		}
+	}
+	i := sort.Search(len(signers), func(i int) bool { return signers[i].Account > signer })
+	signers = append(signers[:i], append([]Signer{entry}, signers[i:]...)...)
+	tx.setSigners(signers)
+	return nil
+}
+
+// VerifySigner reports whether s carries a valid signature of the
+// transaction by its SigningPubKey on behalf of s.Account. It does not tell
+// whether that key controls s.Account.
+func VerifySigner(tx Transaction, s Signer) bool {
+	pubKey, ok := codec.DecodeHex(s.SigningPubKey)
+	if !ok {
+		return false
+	}
+	sig, ok := codec.DecodeHex(s.Signature)
+	if !ok {
+		return false
+	}
+	data, err := MultiSigningData(tx, s.Account)
+	if err != nil {
+		return false
+	}
+	return keys.Verify(pubKey, data, sig)
+}
+
+// ID returns the transaction ID: the hash of the signed canonical encoding,
+// which is also the key of the transaction in the transactions tree
+func ID(tx Transaction) (block.Key, error) {
+	data, err := tx.Serialize()
+	if err != nil {
+		return block.Key{}, err
+	}
+	return block.TransactionKey(data), nil
+}
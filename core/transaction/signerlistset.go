@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transaction
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// SignerListSet replaces the signer list of the account. A zero quorum with
+// no entries removes the list.
+type SignerListSet struct {
+	BaseTransaction
+	SignerQuorum  uint32                `json:"signer_quorum"`
+	SignerEntries []account.SignerEntry `json:"signer_entries"`
+}
+
+func (t *SignerListSet) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *SignerListSet) GetAccount() string {
+	return t.Account
+}
+
+func (t *SignerListSet) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *SignerListSet) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *SignerListSet) Serialize() ([]byte, error) {
+	entries := make([][]byte, len(t.SignerEntries))
+	for i := range t.SignerEntries {
+		var err error
+		if entries[i], err = t.SignerEntries[i].MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Uint32(codec.FieldSignerQuorum, t.SignerQuorum)
+	e.Array(codec.FieldSignerList, entries)
+	return e.Bytes()
+}
+
+func (t *SignerListSet) Deserialize(data []byte) error {
+	*t = SignerListSet{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldSignerQuorum:
+			t.SignerQuorum = d.Uint32()
+		case codec.FieldSignerList:
+			for _, item := range d.Array() {
+				var entry account.SignerEntry
+				if err := entry.UnmarshalBinary(item); err != nil {
+					return err
+				}
+				t.SignerEntries = append(t.SignerEntries, entry)
+			}
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
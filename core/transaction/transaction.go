@@ -20,7 +20,12 @@ package transaction
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
 )
 
 // Transaction defines the interface for all transactions
@@ -29,7 +34,22 @@ type Transaction interface {
 	GetAccount() string
 	GetSequence() uint64
 	GetFee() uint64
+	GetNetworkID() uint32
+	GetSignature() string
+	GetSigningPubKey() string
+	GetSigners() []Signer
+
+	// Serialize returns the canonical binary encoding of the transaction
 	Serialize() ([]byte, error)
+
+	// Deserialize decodes the transaction from its canonical binary encoding
+	Deserialize(data []byte) error
+
+	// setSignature sets the signing public key and the signature
+	setSignature(pubKey, signature string)
+
+	// setSigners replaces the signatures of a multi-signed transaction
+	setSigners(signers []Signer)
 }
 
 // BaseTransaction contains common fields
@@ -40,16 +60,146 @@ type BaseTransaction struct {
 	Fee       uint64    `json:"fee"`
 	Timestamp time.Time `json:"timestamp"`
 	Signature string    `json:"signature"`
+
+	// NetworkID is the network the transaction is meant for, so that a
+	// transaction signed for one network can not be replayed on another
+	NetworkID uint32 `json:"network_id"`
+
+	// SigningPubKey is the hex encoded public key that produced Signature
+	SigningPubKey string `json:"signing_pub_key"`
+
+	// Signers sign for the account in place of Signature when the
+	// transaction is multi-signed, sorted by account
+	Signers []Signer `json:"signers"`
+}
+
+// Signer is the signature of one account of a signer list
+type Signer struct {
+	Account       string `json:"account"`
+	SigningPubKey string `json:"signing_pub_key"`
+	Signature     string `json:"signature"`
 }
 
-// Amount represents a currency amount
-type Amount struct {
-	Value    uint64 `json:"value"`
-	Currency string `json:"currency"`
-	Issuer   string `json:"issuer"`
+// MarshalBinary returns the canonical encoding of the signer
+func (s *Signer) MarshalBinary() ([]byte, error) {
+	e := codec.NewEncoder()
+	e.String(codec.FieldAccount, s.Account)
+	e.String(codec.FieldSignature, s.Signature)
+	e.String(codec.FieldSigningPubKey, s.SigningPubKey)
+	return e.Bytes()
 }
 
-// ParseTransaction parses JSON to Transaction
+// UnmarshalBinary decodes a signer from its canonical encoding
+func (s *Signer) UnmarshalBinary(data []byte) error {
+	*s = Signer{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldAccount:
+			s.Account = d.String()
+		case codec.FieldSignature:
+			s.Signature = d.String()
+		case codec.FieldSigningPubKey:
+			s.SigningPubKey = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
+
+// GetNetworkID returns the network the transaction is meant for
+func (t *BaseTransaction) GetNetworkID() uint32 {
+	return t.NetworkID
+}
+
+// GetSignature returns the signature authorizing the transaction
+func (t *BaseTransaction) GetSignature() string {
+	return t.Signature
+}
+
+// GetSigningPubKey returns the hex encoded public key that signed the transaction
+func (t *BaseTransaction) GetSigningPubKey() string {
+	return t.SigningPubKey
+}
+
+// GetSigners returns the signatures of a multi-signed transaction
+func (t *BaseTransaction) GetSigners() []Signer {
+	return t.Signers
+}
+
+func (t *BaseTransaction) setSignature(pubKey, signature string) {
+	t.SigningPubKey = pubKey
+	t.Signature = signature
+}
+
+func (t *BaseTransaction) setSigners(signers []Signer) {
+	t.Signers = signers
+}
+
+// encode adds the common fields to the encoder. Signers are encoded in the
+// order they are listed.
+func (t *BaseTransaction) encode(e *codec.Encoder) {
+	signers := make([][]byte, len(t.Signers))
+	for i := range t.Signers {
+		// a signer of strings always encodes
+		signers[i], _ = t.Signers[i].MarshalBinary()
+	}
+
+	e.Uint32(codec.FieldTxType, uint32(txTypeValues[t.TxType]))
+	e.Uint32(codec.FieldNetworkID, t.NetworkID)
+	e.Uint64(codec.FieldSequence, t.Sequence)
+	e.Uint64(codec.FieldFee, t.Fee)
+	e.Time(codec.FieldTimestamp, t.Timestamp)
+	e.String(codec.FieldAccount, t.Account)
+	e.String(codec.FieldSignature, t.Signature)
+	e.String(codec.FieldSigningPubKey, t.SigningPubKey)
+	e.Array(codec.FieldSigners, signers)
+}
+
+// decodeField reads the current field if it is a common one, and reports
+// whether it was
+func (t *BaseTransaction) decodeField(d *codec.Decoder) bool {
+	switch d.Field() {
+	case codec.FieldTxType:
+		t.TxType = TxType(d.Uint32()).String()
+	case codec.FieldNetworkID:
+		t.NetworkID = d.Uint32()
+	case codec.FieldSequence:
+		t.Sequence = d.Uint64()
+	case codec.FieldFee:
+		t.Fee = d.Uint64()
+	case codec.FieldTimestamp:
+		t.Timestamp = d.Time()
+	case codec.FieldAccount:
+		t.Account = d.String()
+	case codec.FieldSignature:
+		t.Signature = d.String()
+	case codec.FieldSigningPubKey:
+		t.SigningPubKey = d.String()
+	case codec.FieldSigners:
+		for _, item := range d.Array() {
+			var s Signer
+			if err := s.UnmarshalBinary(item); err != nil {
+				d.Fail(err)
+				break
+			}
+			t.Signers = append(t.Signers, s)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// NativeCurrency is the currency code of EZC, the native asset counted in drops
+const NativeCurrency = amount.NativeCurrency
+
+// Amount represents a currency amount, see amount.Amount
+type Amount = amount.Amount
+
+// ParseTransaction parses JSON to Transaction. Addresses are checked here so
+// that a mistyped address is refused before the transaction goes any further.
 func ParseTransaction(rawTx map[string]interface{}) (Transaction, error) {
 	txType, ok := rawTx["tx_type"].(string)
 	if !ok {
@@ -60,14 +210,184 @@ func ParseTransaction(rawTx map[string]interface{}) (Transaction, error) {
 		return nil, err
 	}
 
-	switch txTypeValues[txType] {
+	tx, err := newTransaction(txTypeValues[txType])
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, tx); err != nil {
+		return nil, err
+	}
+	if err := checkAddresses(tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// checkAddresses validates the account addresses carried by the transaction
+func checkAddresses(tx Transaction) error {
+	if err := address.ValidateAccount(tx.GetAccount()); err != nil {
+		return fmt.Errorf("invalid account %q: %w", tx.GetAccount(), err)
+	}
+	for _, s := range tx.GetSigners() {
+		if err := address.ValidateAccount(s.Account); err != nil {
+			return fmt.Errorf("invalid signer %q: %w", s.Account, err)
+		}
+	}
+
+	switch t := tx.(type) {
+	case *TrustSet:
+		if err := address.ValidateAccount(t.Destination); err != nil {
+			return fmt.Errorf("invalid destination %q: %w", t.Destination, err)
+		}
+	case *TrustConfirm:
+		if err := address.ValidateAccount(t.Destination); err != nil {
+			return fmt.Errorf("invalid destination %q: %w", t.Destination, err)
+		}
+	case *Payment:
+		if err := address.ValidateAccount(t.Destination); err != nil {
+			return fmt.Errorf("invalid destination %q: %w", t.Destination, err)
+		}
+		return checkIssuer(t.Amount)
+	case *EscrowCreate:
+		if err := address.ValidateAccount(t.Destination); err != nil {
+			return fmt.Errorf("invalid destination %q: %w", t.Destination, err)
+		}
+		return checkIssuer(t.Amount)
+	case *EscrowFinish:
+		if err := address.ValidateAccount(t.Owner); err != nil {
+			return fmt.Errorf("invalid owner %q: %w", t.Owner, err)
+		}
+	case *EscrowCancel:
+		if err := address.ValidateAccount(t.Owner); err != nil {
+			return fmt.Errorf("invalid owner %q: %w", t.Owner, err)
+		}
+	case *PaymentChannelCreate:
+		if err := address.ValidateAccount(t.Destination); err != nil {
+			return fmt.Errorf("invalid destination %q: %w", t.Destination, err)
+		}
+		return checkIssuer(t.Amount)
+	case *PaymentChannelFund:
+		return checkIssuer(t.Amount)
+	case *PaymentChannelClaim:
+		if err := address.ValidateAccount(t.Owner); err != nil {
+			return fmt.Errorf("invalid owner %q: %w", t.Owner, err)
+		}
+	case *SignerListSet:
+		for _, entry := range t.SignerEntries {
+			if err := address.ValidateAccount(entry.Account); err != nil {
+				return fmt.Errorf("invalid signer entry %q: %w", entry.Account, err)
+			}
+		}
+	case *OfferCreate:
+		if err := checkIssuer(t.TakerPays); err != nil {
+			return err
+		}
+		return checkIssuer(t.TakerGets)
+	}
+	return nil
+}
+
+// checkIssuer validates the issuer of an issued currency amount
+func checkIssuer(a Amount) error {
+	if a.Issuer != "" {
+		if err := address.ValidateAccount(a.Issuer); err != nil {
+			return fmt.Errorf("invalid issuer %q: %w", a.Issuer, err)
+		}
+	}
+	return nil
+}
+
+// newTransaction returns an empty transaction of the given type
+func newTransaction(txType TxType) (Transaction, error) {
+	switch txType {
+	case TxTypeKYCSet:
+		return &KYCSet{}, nil
+	case TxTypePayment:
+		return &Payment{}, nil
 	case TxTypeTrustSet:
-		var tx TrustSet
-		if err := json.Unmarshal(data, &tx); err != nil {
+		return &TrustSet{}, nil
+	case TxTypeTrustConfirm:
+		return &TrustConfirm{}, nil
+	case TxTypeOfferCreate:
+		return &OfferCreate{}, nil
+	case TxTypeOfferCancel:
+		return &OfferCancel{}, nil
+	case TxTypeAccountSet:
+		return &AccountSet{}, nil
+	case TxTypeSignerListSet:
+		return &SignerListSet{}, nil
+	case TxTypeEscrowCreate:
+		return &EscrowCreate{}, nil
+	case TxTypeEscrowFinish:
+		return &EscrowFinish{}, nil
+	case TxTypeEscrowCancel:
+		return &EscrowCancel{}, nil
+	case TxTypePaymentChannelCreate:
+		return &PaymentChannelCreate{}, nil
+	case TxTypePaymentChannelFund:
+		return &PaymentChannelFund{}, nil
+	case TxTypePaymentChannelClaim:
+		return &PaymentChannelClaim{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported tx_type %v", txType)
+	}
+}
+
+// Decode decodes a transaction of any type from its canonical binary encoding
+func Decode(data []byte) (Transaction, error) {
+	// TxType là field đầu tiên trong mã hoá chuẩn
+	d := codec.NewDecoder(data)
+	if !d.Next() || d.Field() != codec.FieldTxType {
+		if err := d.Err(); err != nil {
 			return nil, err
 		}
-		return &tx, nil
-	default:
-		return nil, errors.New("unsupported tx_type")
+		return nil, errors.New("missing tx_type")
+	}
+
+	tx, err := newTransaction(TxType(d.Uint32()))
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Deserialize(data); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// EncodeSet returns the canonical encoding of a list of transactions, used
+// to hash and sign transaction sets
+func EncodeSet(txs []Transaction) ([]byte, error) {
+	items := make([][]byte, len(txs))
+	for i, tx := range txs {
+		blob, err := tx.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		items[i] = blob
+	}
+
+	e := codec.NewEncoder()
+	e.Array(codec.FieldTransactions, items)
+	return e.Bytes()
+}
+
+// DecodeSet decodes a list of transactions encoded by EncodeSet
+func DecodeSet(data []byte) ([]Transaction, error) {
+	var txs []Transaction
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		switch d.Field() {
+		case codec.FieldTransactions:
+			for _, item := range d.Array() {
+				tx, err := Decode(item)
+				if err != nil {
+					return nil, err
+				}
+				txs = append(txs, tx)
+			}
+		default:
+			d.Skip()
+		}
 	}
+	return txs, d.Err()
 }
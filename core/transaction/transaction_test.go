@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transaction
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+)
+
+func TestTransactionRoundTrip(t *testing.T) {
+	tx := &TrustSet{
+		BaseTransaction: BaseTransaction{
+			TxType:    "TrustSet",
+			Account:   "alice",
+			Sequence:  3,
+			Fee:       10,
+			Signature: "sig",
+			NetworkID: 2,
+		},
+		Destination: "bob",
+		Currency:    "USD",
+		Limit:       amount.FromUint64(500),
+		Conditions:  []string{"kyc"},
+		ExpiresAt:   time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	data, err := tx.Serialize()
+	if err != nil {
+		t.Fatalf("serialize: %v", err)
+	}
+	decoded, err := Decode(data)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, tx) {
+		t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", decoded, tx)
+	}
+
+	again, err := decoded.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(again, data) {
+		t.Fatal("re-encoding changed the bytes")
+	}
+}
+
+func TestEncodeSet(t *testing.T) {
+	txs := []Transaction{
+		&TrustSet{BaseTransaction: BaseTransaction{TxType: "TrustSet", Account: "alice", Sequence: 1}, Currency: "USD"},
+		&TrustSet{BaseTransaction: BaseTransaction{TxType: "TrustSet", Account: "bob", Sequence: 2}, Currency: "EUR"},
+		&Payment{
+			BaseTransaction: BaseTransaction{TxType: "Payment", Account: "carol", Sequence: 3},
+			Destination:     "alice",
+			Amount:          Amount{Value: amount.FromUint64(5), Currency: "USD", Issuer: "bob"},
+		},
+	}
+
+	data, err := EncodeSet(txs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodeSet(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, txs) {
+		t.Fatalf("set round trip mismatch: %+v", decoded)
+	}
+}
+
+func TestDecodeRejectsUnknownType(t *testing.T) {
+	if _, err := Decode([]byte{}); err == nil {
+		t.Fatal("expected error for missing tx type")
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	_, priv, err := keys.GenerateKey(keys.DefaultScheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &TrustSet{
+		BaseTransaction: BaseTransaction{TxType: "TrustSet", Account: "alice", Sequence: 1, Fee: 10},
+		Destination:     "bob",
+		Currency:        "USD",
+		Limit:           amount.FromUint64(100),
+	}
+	if err := Sign(tx, priv); err != nil {
+		t.Fatal(err)
+	}
+	if tx.SigningPubKey == "" || !VerifySignature(tx) {
+		t.Fatal("signed transaction does not verify")
+	}
+
+	id, err := ID(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := tx.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifySignature(decoded) {
+		t.Fatal("decoded transaction does not verify")
+	}
+	if decodedID, _ := ID(decoded); decodedID != id {
+		t.Fatal("transaction ID changed after a round trip")
+	}
+
+	tx.Limit = amount.FromUint64(200)
+	if VerifySignature(tx) {
+		t.Fatal("modified transaction still verifies")
+	}
+	if changedID, _ := ID(tx); changedID == id {
+		t.Fatal("modified transaction kept its ID")
+	}
+}
+
+func TestMultiSign(t *testing.T) {
+	tx := &Payment{
+		BaseTransaction: BaseTransaction{TxType: "Payment", Account: "alice", Sequence: 1, Fee: 30},
+		Destination:     "bob",
+		Amount:          Amount{Value: amount.FromUint64(5)},
+	}
+	for i := 0; i < 2; i++ {
+		_, priv, err := keys.GenerateKey(keys.DefaultScheme)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := MultiSign(tx, priv); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(tx.Signers) != 2 || tx.Signers[0].Account >= tx.Signers[1].Account {
+		t.Fatalf("got signers %+v", tx.Signers)
+	}
+
+	data, err := tx.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, tx) {
+		t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", decoded, tx)
+	}
+	for _, s := range decoded.GetSigners() {
+		if !VerifySigner(decoded, s) {
+			t.Fatalf("signer %s does not verify", s.Account)
+		}
+	}
+
+	// a signature only counts for the account it was made for
+	forged := tx.Signers[0]
+	forged.Account = tx.Signers[1].Account
+	if VerifySigner(tx, forged) {
+		t.Fatal("signature verified for another signer")
+	}
+}
+
+func TestParseTransactionChecksAddresses(t *testing.T) {
+	pub, _, err := keys.GenerateKey(keys.DefaultScheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey, err := keys.MarshalPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := address.FromPublicKey(pubKey)
+	typo := alice[:len(alice)-1] + "2"
+	if typo == alice {
+		typo = alice[:len(alice)-1] + "3"
+	}
+
+	raw := func(account, destination string) map[string]interface{} {
+		return map[string]interface{}{
+			"tx_type": "TrustSet", "account": account, "destination": destination, "currency": "USD",
+		}
+	}
+	if _, err := ParseTransaction(raw(alice, alice)); err != nil {
+		t.Fatalf("valid addresses rejected: %v", err)
+	}
+	if _, err := ParseTransaction(raw(typo, alice)); err == nil {
+		t.Fatal("mistyped account accepted")
+	}
+	if _, err := ParseTransaction(raw(alice, typo)); err == nil {
+		t.Fatal("mistyped destination accepted")
+	}
+}
@@ -25,9 +25,10 @@ import (
 // TxType represents a transaction type
 type TxType int
 
-// Transaction transaction
+// Transaction transaction. Values start at 1 so that the zero value (and any
+// unknown name looked up in txTypeValues) is not a valid type
 const (
-	TxTypeKYCSet TxType = iota
+	TxTypeKYCSet TxType = iota + 1
 	TxTypePayment
 	TxTypeTrustSet
 	TxTypeTrustConfirm
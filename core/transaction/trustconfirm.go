@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transaction
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+)
+
+// TrustConfirm is sent by the issuer to confirm the pending trust line that
+// Destination opened toward it for Currency
+type TrustConfirm struct {
+	BaseTransaction
+	Destination string `json:"destination"`
+	Currency    string `json:"currency"`
+}
+
+func (t *TrustConfirm) GetTxType() TxType {
+	return txTypeValues[t.TxType]
+}
+
+func (t *TrustConfirm) GetAccount() string {
+	return t.Account
+}
+
+func (t *TrustConfirm) GetSequence() uint64 {
+	return t.Sequence
+}
+
+func (t *TrustConfirm) GetFee() uint64 {
+	return t.Fee
+}
+
+func (t *TrustConfirm) Serialize() ([]byte, error) {
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.String(codec.FieldDestination, t.Destination)
+	e.String(codec.FieldCurrency, t.Currency)
+	return e.Bytes()
+}
+
+func (t *TrustConfirm) Deserialize(data []byte) error {
+	*t = TrustConfirm{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldDestination:
+			t.Destination = d.String()
+		case codec.FieldCurrency:
+			t.Currency = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
+}
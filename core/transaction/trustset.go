@@ -18,18 +18,20 @@
 package transaction
 
 import (
-	"encoding/json"
 	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
 )
 
 // TrustSet transaction
 type TrustSet struct {
 	BaseTransaction
-	Destination string    `json:"destination"`
-	Currency    string    `json:"currency"`
-	Limit       uint64    `json:"limit"`
-	Conditions  []string  `json:"conditions"`
-	ExpiresAt   time.Time `json:"expires_at"`
+	Destination string       `json:"destination"`
+	Currency    string       `json:"currency"`
+	Limit       amount.Value `json:"limit"`
+	Conditions  []string     `json:"conditions"`
+	ExpiresAt   time.Time    `json:"expires_at"`
 }
 
 func (t *TrustSet) GetTxType() TxType {
@@ -49,5 +51,44 @@ func (t *TrustSet) GetFee() uint64 {
 }
 
 func (t *TrustSet) Serialize() ([]byte, error) {
-	return json.Marshal(t)
+	limit, err := t.Limit.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	e := codec.NewEncoder()
+	t.BaseTransaction.encode(e)
+	e.Time(codec.FieldExpiresAt, t.ExpiresAt)
+	e.Blob(codec.FieldLimitValue, limit)
+	e.String(codec.FieldDestination, t.Destination)
+	e.String(codec.FieldCurrency, t.Currency)
+	e.StringArray(codec.FieldConditions, t.Conditions)
+	return e.Bytes()
+}
+
+func (t *TrustSet) Deserialize(data []byte) error {
+	*t = TrustSet{}
+	d := codec.NewDecoder(data)
+	for d.Next() {
+		if t.BaseTransaction.decodeField(d) {
+			continue
+		}
+		switch d.Field() {
+		case codec.FieldLimitValue:
+			if err := t.Limit.UnmarshalBinary(d.Blob()); err != nil {
+				return err
+			}
+		case codec.FieldExpiresAt:
+			t.ExpiresAt = d.Time()
+		case codec.FieldDestination:
+			t.Destination = d.String()
+		case codec.FieldCurrency:
+			t.Currency = d.String()
+		case codec.FieldConditions:
+			t.Conditions = d.StringArray()
+		default:
+			d.Skip()
+		}
+	}
+	return d.Err()
 }
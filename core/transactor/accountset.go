@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+)
+
+// maxDomainLength bounds the domain an account can publish
+const maxDomainLength = 256
+
+type accountSetTransactor struct{}
+
+func (accountSetTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.AccountSet)
+	if !ok {
+		return fmt.Errorf("%w: not an AccountSet", ErrMalformed)
+	}
+	if (t.SetFlags|t.ClearFlags)&^account.AllFlags != 0 {
+		return fmt.Errorf("%w: unknown account flags", ErrMalformed)
+	}
+	if t.SetFlags&t.ClearFlags != 0 {
+		return fmt.Errorf("%w: flags both set and cleared", ErrMalformed)
+	}
+	if t.Clear&^(transaction.ClearDomain|transaction.ClearMessageKey|transaction.ClearTransferRate) != 0 {
+		return fmt.Errorf("%w: unknown settings to clear", ErrMalformed)
+	}
+
+	if len(t.Domain) > maxDomainLength {
+		return fmt.Errorf("%w: domain longer than %d bytes", ErrMalformed, maxDomainLength)
+	}
+	if t.MessageKey != "" {
+		pubKey, err := hex.DecodeString(t.MessageKey)
+		if err != nil {
+			return fmt.Errorf("%w: message key: %v", ErrMalformed, err)
+		}
+		if _, err := keys.ParsePublicKey(pubKey); err != nil {
+			return fmt.Errorf("%w: message key: %v", ErrMalformed, err)
+		}
+	}
+	if t.TransferRate != 0 && (t.TransferRate < account.TransferRateParity || t.TransferRate > account.MaxTransferRate) {
+		return fmt.Errorf("%w: transfer rate %d out of range", ErrMalformed, t.TransferRate)
+	}
+
+	if t.Clear&transaction.ClearDomain != 0 && t.Domain != "" ||
+		t.Clear&transaction.ClearMessageKey != 0 && t.MessageKey != "" ||
+		t.Clear&transaction.ClearTransferRate != 0 && t.TransferRate != 0 {
+		return fmt.Errorf("%w: setting both changed and cleared", ErrMalformed)
+	}
+	return nil
+}
+
+// Preclaim keeps the account from disabling its master key without a
+// signer list to sign with instead
+func (accountSetTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.AccountSet)
+	if t.SetFlags&account.FlagDisableMaster != 0 && ctx.Account.SignerQuorum == 0 {
+		return ErrNoAlternativeKey
+	}
+	return nil
+}
+
+// DoApply updates the flags and settings of the account
+func (accountSetTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.AccountSet)
+	acc := ctx.Account
+
+	acc.Flags = (acc.Flags | t.SetFlags) &^ t.ClearFlags
+
+	if t.Domain != "" {
+		acc.Domain = t.Domain
+	}
+	if t.MessageKey != "" {
+		acc.MessageKey = t.MessageKey
+	}
+	if t.TransferRate != 0 {
+		acc.TransferRate = t.TransferRate
+	}
+	if t.Clear&transaction.ClearDomain != 0 {
+		acc.Domain = ""
+	}
+	if t.Clear&transaction.ClearMessageKey != 0 {
+		acc.MessageKey = ""
+	}
+	if t.Clear&transaction.ClearTransferRate != 0 {
+		acc.TransferRate = 0
+	}
+
+	return ctx.State.SetAccount(acc)
+}
+
+// checkCounterparties enforces FlagRequireKYCForCounterparties between two
+// accounts dealing with each other
+func checkCounterparties(a, b *account.Account) error {
+	if a.HasFlag(account.FlagRequireKYCForCounterparties) && !b.KYCVerified ||
+		b.HasFlag(account.FlagRequireKYCForCounterparties) && !a.KYCVerified {
+		return ErrKYCRequired
+	}
+	return nil
+}
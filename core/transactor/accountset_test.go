@@ -0,0 +1,223 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account/trustline"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// setFlags sets account flags directly in the state
+func setFlags(t *testing.T, st *state.State, name string, flags uint32) {
+	t.Helper()
+	acc, err := st.Account(testAccount(t, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	acc.Flags |= flags
+	if err := st.SetAccount(acc); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAccountSet(t *testing.T) {
+	st := testState(t)
+	messageKey := hex.EncodeToString(testPubKey(t, "alice-messages"))
+
+	set := testTx(t, "alice", 1, transaction.TxTypeAccountSet, &transaction.AccountSet{}, func(tx *transaction.AccountSet) {
+		tx.SetFlags = account.FlagRequireDestTag | account.FlagGlobalFreeze
+		tx.Domain = "example.com"
+		tx.MessageKey = messageKey
+		tx.TransferRate = 1_002_000_000
+	})
+	if err := Apply(st, set, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	unset := testTx(t, "alice", 2, transaction.TxTypeAccountSet, &transaction.AccountSet{}, func(tx *transaction.AccountSet) {
+		tx.ClearFlags = account.FlagGlobalFreeze
+		tx.Clear = transaction.ClearDomain
+	})
+	if err := Apply(st, unset, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+
+	acc, err := st.Account(testAccount(t, "alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acc.Flags != account.FlagRequireDestTag || acc.Domain != "" || acc.MessageKey != messageKey || acc.TransferRate != 1_002_000_000 {
+		t.Fatalf("got account %+v", acc)
+	}
+
+	tests := []struct {
+		name string
+		edit func(tx *transaction.AccountSet)
+	}{
+		{"unknown flag", func(tx *transaction.AccountSet) { tx.SetFlags = 1 << 31 }},
+		{"set and cleared", func(tx *transaction.AccountSet) {
+			tx.SetFlags, tx.ClearFlags = account.FlagDefaultRipple, account.FlagDefaultRipple
+		}},
+		{"rate below parity", func(tx *transaction.AccountSet) { tx.TransferRate = 999_999_999 }},
+		{"rate above maximum", func(tx *transaction.AccountSet) { tx.TransferRate = account.MaxTransferRate + 1 }},
+		{"bad message key", func(tx *transaction.AccountSet) { tx.MessageKey = "00ff" }},
+		{"domain set and cleared", func(tx *transaction.AccountSet) {
+			tx.Domain, tx.Clear = "example.com", transaction.ClearDomain
+		}},
+	}
+	for _, test := range tests {
+		if err := Apply(st, testTx(t, "alice", 3, transaction.TxTypeAccountSet, &transaction.AccountSet{}, test.edit), testCloseTime); !errors.Is(err, ErrMalformed) {
+			t.Fatalf("%s: got %v", test.name, err)
+		}
+	}
+}
+
+func TestAccountFlags(t *testing.T) {
+	usd := func(v uint64) transaction.Amount {
+		return transaction.Amount{Value: amount.FromUint64(v), Currency: "USD", Issuer: testAccount(t, "bob")}
+	}
+	newState := func() *state.State {
+		st := testState(t)
+		if err := Fund(st, testAccount(t, "carol"), 1000); err != nil {
+			t.Fatal(err)
+		}
+		return st
+	}
+
+	t.Run("RequireDestTag", func(t *testing.T) {
+		st := newState()
+		setFlags(t, st, "bob", account.FlagRequireDestTag)
+		if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: transaction.Amount{Value: amount.FromUint64(5)}}), testCloseTime); !errors.Is(err, ErrDestTagRequired) {
+			t.Fatalf("got %v", err)
+		}
+		tagged := testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: transaction.Amount{Value: amount.FromUint64(5)}}, func(tx *transaction.Payment) {
+			tx.DestinationTag = 42
+		})
+		if err := Apply(st, tagged, testCloseTime); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("DisallowIncomingTrustlines", func(t *testing.T) {
+		st := newState()
+		setFlags(t, st, "bob", account.FlagDisallowIncomingTrustlines)
+		if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t)), testCloseTime); !errors.Is(err, ErrTrustDisallowed) {
+			t.Fatalf("got %v", err)
+		}
+	})
+
+	t.Run("RequireKYCForCounterparties", func(t *testing.T) {
+		st := newState()
+		setFlags(t, st, "bob", account.FlagRequireKYCForCounterparties)
+		if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: transaction.Amount{Value: amount.FromUint64(5)}}), testCloseTime); !errors.Is(err, ErrKYCRequired) {
+			t.Fatalf("payment: got %v", err)
+		}
+		if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t)), testCloseTime); !errors.Is(err, ErrKYCRequired) {
+			t.Fatalf("trust line: got %v", err)
+		}
+
+		// an account created by the payment has no KYC yet
+		setFlags(t, st, "alice", account.FlagRequireKYCForCounterparties)
+		if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "dave"), Amount: transaction.Amount{Value: amount.FromUint64(5)}}), testCloseTime); !errors.Is(err, ErrKYCRequired) {
+			t.Fatalf("payment to a new account: got %v", err)
+		}
+	})
+
+	t.Run("DefaultRipple", func(t *testing.T) {
+		st := newState()
+		if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t)), testCloseTime); err != nil {
+			t.Fatal(err)
+		}
+		acc, _ := st.Account(testAccount(t, "alice"))
+		if acc.TrustLines[0].Flags&trustline.FlagNoRipple == 0 {
+			t.Fatal("line toward an issuer without DefaultRipple should not ripple")
+		}
+
+		// lines that do not ripple keep holders from paying each other
+		addLine(t, st, "carol", "bob", 100, 0)
+		acc.TrustLines[0].IsVerified, acc.TrustLines[0].Balance = true, amount.FromUint64(50)
+		if err := st.SetAccount(acc); err != nil {
+			t.Fatal(err)
+		}
+		if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "carol"), Amount: usd(10)}), testCloseTime); !errors.Is(err, ErrNoRipple) {
+			t.Fatalf("got %v", err)
+		}
+
+		setFlags(t, st, "bob", account.FlagDefaultRipple)
+		if err := Fund(st, testAccount(t, "dave"), 1000); err != nil {
+			t.Fatal(err)
+		}
+		if err := Apply(st, testTx(t, "dave", 1, transaction.TxTypeTrustSet, usdLine(t)), testCloseTime); err != nil {
+			t.Fatal(err)
+		}
+		acc, _ = st.Account(testAccount(t, "dave"))
+		if acc.TrustLines[0].Flags&trustline.FlagNoRipple != 0 {
+			t.Fatal("line toward an issuer with DefaultRipple should ripple")
+		}
+	})
+
+	t.Run("GlobalFreeze", func(t *testing.T) {
+		st := newState()
+		addLine(t, st, "alice", "bob", 100, 50)
+		addLine(t, st, "carol", "bob", 100, 0)
+		setFlags(t, st, "bob", account.FlagGlobalFreeze)
+		if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "carol"), Amount: usd(10)}), testCloseTime); !errors.Is(err, ErrFrozen) {
+			t.Fatalf("between holders: got %v", err)
+		}
+		if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: usd(10)}), testCloseTime); err != nil {
+			t.Fatalf("back to the issuer: %v", err)
+		}
+	})
+
+	t.Run("TransferRate", func(t *testing.T) {
+		st := newState()
+		addLine(t, st, "alice", "bob", 200, 200)
+		addLine(t, st, "carol", "bob", 200, 0)
+		bob, _ := st.Account(testAccount(t, "bob"))
+		bob.TransferRate = 1_500_000_000
+		if err := st.SetAccount(bob); err != nil {
+			t.Fatal(err)
+		}
+		if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "carol"), Amount: usd(100)}), testCloseTime); err != nil {
+			t.Fatal(err)
+		}
+		if lineBalance(t, st, "alice") != 50 || lineBalance(t, st, "carol") != 100 {
+			t.Fatalf("got alice %d, carol %d", lineBalance(t, st, "alice"), lineBalance(t, st, "carol"))
+		}
+	})
+
+	t.Run("RequireAuth", func(t *testing.T) {
+		st := newState()
+		addLine(t, st, "alice", "bob", 100, 0)
+		setFlags(t, st, "bob", account.FlagRequireAuth)
+		raise := testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) { tx.Limit = amount.FromUint64(500) })
+		if err := Apply(st, raise, testCloseTime); err != nil {
+			t.Fatal(err)
+		}
+		acc, _ := st.Account(testAccount(t, "alice"))
+		if acc.TrustLines[0].IsVerified {
+			t.Fatal("raised limit should need a new confirmation")
+		}
+	})
+}
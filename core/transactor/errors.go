@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import "errors"
+
+var (
+	ErrMalformed         = errors.New("transactor: malformed transaction")
+	ErrUnsupportedTxType = errors.New("transactor: unsupported transaction type")
+	ErrWrongNetwork      = errors.New("transactor: transaction is for another network")
+	ErrMissingSignature  = errors.New("transactor: missing signature")
+	ErrBadSignature      = errors.New("transactor: invalid signature")
+	ErrBadSigner         = errors.New("transactor: signing key does not control the account")
+	ErrNoAccount         = errors.New("transactor: source account does not exist")
+	ErrNoDestination     = errors.New("transactor: destination account does not exist")
+	ErrBadSequence       = errors.New("transactor: bad sequence")
+	ErrFeeTooLow         = errors.New("transactor: fee below the network base fee")
+	ErrInsufficientFee   = errors.New("transactor: balance does not cover the fee")
+	ErrInsufficientFunds = errors.New("transactor: insufficient funds")
+	ErrNoTrustLine       = errors.New("transactor: no trust line for the currency")
+	ErrTrustLimit        = errors.New("transactor: amount exceeds the trust limit")
+	ErrTrustNotConfirmed = errors.New("transactor: trust line is not confirmed")
+	ErrTrustConfirmed    = errors.New("transactor: trust line is already confirmed")
+	ErrTrustExpired      = errors.New("transactor: trust line offer has expired")
+	ErrNoOffer           = errors.New("transactor: offer does not exist")
+	ErrOfferExpired      = errors.New("transactor: offer has expired")
+	ErrDestTagRequired   = errors.New("transactor: destination requires a destination tag")
+	ErrTrustDisallowed   = errors.New("transactor: destination does not accept trust lines")
+	ErrKYCRequired       = errors.New("transactor: counterparty is not KYC verified")
+	ErrNoRipple          = errors.New("transactor: trust line does not allow rippling")
+	ErrFrozen            = errors.New("transactor: currency is frozen by its issuer")
+	ErrMasterDisabled    = errors.New("transactor: master key is disabled")
+	ErrNoSignerList      = errors.New("transactor: account has no signer list")
+	ErrBadQuorum         = errors.New("transactor: signer weights do not reach the quorum")
+	ErrNoAlternativeKey  = errors.New("transactor: account would be left without a way to sign")
+	ErrNoEscrow          = errors.New("transactor: escrow does not exist")
+	ErrEscrowNotReady    = errors.New("transactor: escrow cannot be released yet")
+	ErrEscrowExpired     = errors.New("transactor: escrow has expired")
+	ErrEscrowCondition   = errors.New("transactor: fulfillment does not match the escrow condition")
+	ErrNoChannel         = errors.New("transactor: payment channel does not exist")
+	ErrChannelExpired    = errors.New("transactor: payment channel has expired")
+	ErrBadExpiration     = errors.New("transactor: expiration is before the settle delay")
+	ErrNotChannelParty   = errors.New("transactor: account is not a party to the channel")
+	ErrBadClaim          = errors.New("transactor: invalid payment channel claim")
+	ErrKYCHash           = errors.New("transactor: KYC hash does not match the KYC data")
+	ErrKYCSignature      = errors.New("transactor: invalid KYC provider signature")
+	ErrUnknownProvider   = errors.New("transactor: KYC provider is not recognised")
+
+	ErrInsufficientReserve = errors.New("transactor: balance does not cover the reserve")
+)
@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/block/escrow"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+type escrowCreateTransactor struct{}
+
+func (escrowCreateTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.EscrowCreate)
+	if !ok {
+		return fmt.Errorf("%w: not an EscrowCreate", ErrMalformed)
+	}
+	if err := address.ValidateAccount(t.Destination); err != nil {
+		return fmt.Errorf("%w: destination: %v", ErrMalformed, err)
+	}
+	if err := checkAmount(t.Amount); err != nil {
+		return err
+	}
+	if t.FinishAfter.IsZero() && len(t.Condition) == 0 {
+		return fmt.Errorf("%w: escrow needs a finish time or a condition", ErrMalformed)
+	}
+	if !t.FinishAfter.IsZero() && !t.CancelAfter.IsZero() && !t.CancelAfter.After(t.FinishAfter) {
+		return fmt.Errorf("%w: cancel time not after finish time", ErrMalformed)
+	}
+	if len(t.Condition) != 0 && len(t.Condition) != escrow.ConditionSize {
+		return fmt.Errorf("%w: condition must be a %d byte hash", ErrMalformed, escrow.ConditionSize)
+	}
+	return nil
+}
+
+// Preclaim checks the destination, then locks the amount on a copy of the
+// state, after the fee, so that an escrow passing Check can be funded
+func (escrowCreateTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.EscrowCreate)
+	dest, err := ctx.State.Account(t.Destination)
+	if errors.Is(err, state.ErrAccountNotFound) {
+		return ErrNoDestination
+	}
+	if err != nil {
+		return err
+	}
+	if dest.HasFlag(account.FlagRequireDestTag) && t.DestinationTag == 0 {
+		return ErrDestTagRequired
+	}
+	if err := checkCounterparties(ctx.Account, dest); err != nil {
+		return err
+	}
+	if !t.CancelAfter.IsZero() && !ctx.CloseTime.Before(t.CancelAfter) {
+		return ErrEscrowExpired
+	}
+
+	scratch := state.New(ctx.State.Tree())
+	acc := *ctx.Account
+	acc.Balance -= t.Fee
+	if err := scratch.SetAccount(&acc); err != nil {
+		return err
+	}
+	return lockFunds(scratch, t.Account, t.Amount)
+}
+
+// DoApply takes the amount from the account into a new escrow
+func (escrowCreateTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.EscrowCreate)
+	if err := lockFunds(ctx.State, t.Account, t.Amount); err != nil {
+		return err
+	}
+	if err := adjustOwnerCount(ctx.State, t.Account, 1); err != nil {
+		return err
+	}
+	return ctx.State.SetEscrow(&escrow.Escrow{
+		Account:     t.Account,
+		Sequence:    t.Sequence,
+		Destination: t.Destination,
+		Amount:      t.Amount,
+		FinishAfter: t.FinishAfter,
+		CancelAfter: t.CancelAfter,
+		Condition:   t.Condition,
+	})
+}
+
+type escrowFinishTransactor struct{}
+
+func (escrowFinishTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.EscrowFinish)
+	if !ok {
+		return fmt.Errorf("%w: not an EscrowFinish", ErrMalformed)
+	}
+	return checkEscrowRef(t.Owner, t.EscrowSequence)
+}
+
+// Preclaim checks that the escrow can be finished now with the given
+// fulfillment, then releases it on a copy of the state
+func (escrowFinishTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.EscrowFinish)
+	e, err := loadEscrow(ctx.State, t.Owner, t.EscrowSequence)
+	if err != nil {
+		return err
+	}
+	if e.Expired(ctx.CloseTime) {
+		return ErrEscrowExpired
+	}
+	if !e.CanFinish(ctx.CloseTime) {
+		return ErrEscrowNotReady
+	}
+	if !e.Fulfils(t.Fulfillment) {
+		return ErrEscrowCondition
+	}
+	return closeEscrow(state.New(ctx.State.Tree()), e, e.Destination)
+}
+
+// DoApply pays the escrow to its destination
+func (escrowFinishTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.EscrowFinish)
+	e, err := ctx.State.Escrow(t.Owner, t.EscrowSequence)
+	if err != nil {
+		return err
+	}
+	return closeEscrow(ctx.State, e, e.Destination)
+}
+
+type escrowCancelTransactor struct{}
+
+func (escrowCancelTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.EscrowCancel)
+	if !ok {
+		return fmt.Errorf("%w: not an EscrowCancel", ErrMalformed)
+	}
+	return checkEscrowRef(t.Owner, t.EscrowSequence)
+}
+
+// Preclaim checks that the cancel time of the escrow has passed
+func (escrowCancelTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.EscrowCancel)
+	e, err := loadEscrow(ctx.State, t.Owner, t.EscrowSequence)
+	if err != nil {
+		return err
+	}
+	if !e.Expired(ctx.CloseTime) {
+		return ErrEscrowNotReady
+	}
+	return nil
+}
+
+// DoApply returns the escrow to its owner
+func (escrowCancelTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.EscrowCancel)
+	e, err := ctx.State.Escrow(t.Owner, t.EscrowSequence)
+	if err != nil {
+		return err
+	}
+	return closeEscrow(ctx.State, e, e.Account)
+}
+
+func checkEscrowRef(owner string, sequence uint64) error {
+	if err := address.ValidateAccount(owner); err != nil {
+		return fmt.Errorf("%w: owner: %v", ErrMalformed, err)
+	}
+	if sequence == 0 {
+		return fmt.Errorf("%w: missing escrow sequence", ErrMalformed)
+	}
+	return nil
+}
+
+func loadEscrow(st *state.State, owner string, sequence uint64) (*escrow.Escrow, error) {
+	e, err := st.Escrow(owner, sequence)
+	if errors.Is(err, state.ErrEscrowNotFound) {
+		return nil, ErrNoEscrow
+	}
+	return e, err
+}
+
+// lockFunds takes amount from the owner. An issuer locks nothing of its own
+// currency, which is issued when the escrow is released.
+func lockFunds(st *state.State, owner string, amount transaction.Amount) error {
+	if amount.IsNative() {
+		return debitNative(st, owner, drops(amount))
+	}
+
+	if owner == amount.Issuer {
+		return nil
+	}
+	frozen, err := isFrozen(st, amount.Issue())
+	if err != nil {
+		return err
+	}
+	if frozen {
+		return ErrFrozen
+	}
+	return debitLine(st, owner, amount, amount.Value, false)
+}
+
+// closeEscrow pays the amount of the escrow to the receiver, its destination
+// or its owner, and deletes it. Funds going back to the owner are not held
+// to the limit of its trust line, since they were on it before. Funds going
+// to another holder are put back on the owner's line and moved by transfer,
+// like a payment, so the transfer fee and NoRipple of the lines apply.
+func closeEscrow(st *state.State, e *escrow.Escrow, receiver string) error {
+	amount := e.Amount
+	switch {
+	case amount.IsNative():
+		if err := Fund(st, receiver, drops(amount)); err != nil {
+			return err
+		}
+	case receiver == amount.Issuer:
+		// the currency is redeemed
+	case receiver == e.Account:
+		if err := refundLine(st, receiver, amount); err != nil {
+			return err
+		}
+	default:
+		frozen, err := isFrozen(st, amount.Issue())
+		if err != nil {
+			return err
+		}
+		if frozen {
+			return ErrFrozen
+		}
+		if e.Account != amount.Issuer {
+			if err := refundLine(st, e.Account, amount); err != nil {
+				return err
+			}
+		}
+		if err := transfer(st, e.Account, receiver, amount); err != nil {
+			return err
+		}
+	}
+
+	if err := st.DeleteEscrow(e.Account, e.Sequence); err != nil {
+		return err
+	}
+	return adjustOwnerCount(st, e.Account, -1)
+}
+
+// refundLine puts amount back on the holder's trust line
+func refundLine(st *state.State, holder string, amount transaction.Amount) error {
+	acc, err := st.Account(holder)
+	if err != nil {
+		return err
+	}
+	line := findLine(acc, amount)
+	if line == nil {
+		return ErrNoTrustLine
+	}
+	if line.Balance, err = line.Balance.Add(amount.Value); err != nil {
+		return err
+	}
+	return st.SetAccount(acc)
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"crypto/sha256"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account/trustline"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// bobEscrow returns an escrow of 100 drops to bob
+func bobEscrow(t *testing.T) *transaction.EscrowCreate {
+	return &transaction.EscrowCreate{
+		Destination: testAccount(t, "bob"),
+		Amount:      transaction.Amount{Value: amount.FromUint64(100)},
+	}
+}
+
+func balance(t *testing.T, st *state.State, name string) (uint64, uint32) {
+	t.Helper()
+	acc, err := st.Account(testAccount(t, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return acc.Balance, acc.OwnerCount
+}
+
+func TestEscrowTimes(t *testing.T) {
+	st := testState(t)
+	create := testTx(t, "alice", 1, transaction.TxTypeEscrowCreate, bobEscrow(t), func(tx *transaction.EscrowCreate) {
+		tx.FinishAfter = testCloseTime.Add(time.Hour)
+		tx.CancelAfter = testCloseTime.Add(2 * time.Hour)
+	})
+	if err := Apply(st, create, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got, owned := balance(t, st, "alice"); got != 890 || owned != 1 {
+		t.Fatalf("got alice balance %d owning %d", got, owned)
+	}
+
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeEscrowFinish, &transaction.EscrowFinish{Owner: testAccount(t, "alice"), EscrowSequence: 1}), testCloseTime); !errors.Is(err, ErrEscrowNotReady) {
+		t.Fatalf("finish too early: got %v", err)
+	}
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypeEscrowCancel, &transaction.EscrowCancel{Owner: testAccount(t, "alice"), EscrowSequence: 1}), testCloseTime.Add(90*time.Minute)); !errors.Is(err, ErrEscrowNotReady) {
+		t.Fatalf("cancel too early: got %v", err)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeEscrowFinish, &transaction.EscrowFinish{Owner: testAccount(t, "alice"), EscrowSequence: 1}), testCloseTime.Add(90*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := balance(t, st, "bob"); got != 1000-10+100 {
+		t.Fatalf("got bob balance %d", got)
+	}
+	if _, owned := balance(t, st, "alice"); owned != 0 {
+		t.Fatalf("alice still owns %d objects", owned)
+	}
+	if err := Apply(st, testTx(t, "bob", 2, transaction.TxTypeEscrowFinish, &transaction.EscrowFinish{Owner: testAccount(t, "alice"), EscrowSequence: 1}), testCloseTime.Add(90*time.Minute)); !errors.Is(err, ErrNoEscrow) {
+		t.Fatalf("finish twice: got %v", err)
+	}
+}
+
+func TestEscrowDestinationTag(t *testing.T) {
+	st := testState(t)
+	setFlags(t, st, "bob", account.FlagRequireDestTag)
+	create := func(tag uint32) *transaction.EscrowCreate {
+		return testTx(t, "alice", 1, transaction.TxTypeEscrowCreate, bobEscrow(t), func(tx *transaction.EscrowCreate) {
+			tx.FinishAfter = testCloseTime
+			tx.DestinationTag = tag
+		})
+	}
+	if err := Apply(st, create(0), testCloseTime); !errors.Is(err, ErrDestTagRequired) {
+		t.Fatalf("got %v", err)
+	}
+	if err := Apply(st, create(7), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEscrowCancel(t *testing.T) {
+	st := testState(t)
+	create := testTx(t, "alice", 1, transaction.TxTypeEscrowCreate, bobEscrow(t), func(tx *transaction.EscrowCreate) {
+		tx.FinishAfter = testCloseTime.Add(time.Hour)
+		tx.CancelAfter = testCloseTime.Add(2 * time.Hour)
+	})
+	if err := Apply(st, create, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+
+	late := testCloseTime.Add(3 * time.Hour)
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeEscrowFinish, &transaction.EscrowFinish{Owner: testAccount(t, "alice"), EscrowSequence: 1}), late); !errors.Is(err, ErrEscrowExpired) {
+		t.Fatalf("finish after cancel time: got %v", err)
+	}
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypeEscrowCancel, &transaction.EscrowCancel{Owner: testAccount(t, "alice"), EscrowSequence: 1}), late); err != nil {
+		t.Fatal(err)
+	}
+	if got, owned := balance(t, st, "alice"); got != 1000-20 || owned != 0 {
+		t.Fatalf("got alice balance %d owning %d", got, owned)
+	}
+}
+
+func TestEscrowCondition(t *testing.T) {
+	st := testState(t)
+	condition := sha256.Sum256([]byte("milestone 1"))
+	create := testTx(t, "alice", 1, transaction.TxTypeEscrowCreate, bobEscrow(t), func(tx *transaction.EscrowCreate) { tx.Condition = condition[:] })
+	if err := Apply(st, create, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeEscrowFinish, &transaction.EscrowFinish{Owner: testAccount(t, "alice"), EscrowSequence: 1}), testCloseTime); !errors.Is(err, ErrEscrowCondition) {
+		t.Fatalf("no fulfillment: got %v", err)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeEscrowFinish, &transaction.EscrowFinish{Owner: testAccount(t, "alice"), EscrowSequence: 1, Fulfillment: []byte("milestone 2")}), testCloseTime); !errors.Is(err, ErrEscrowCondition) {
+		t.Fatalf("wrong fulfillment: got %v", err)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeEscrowFinish, &transaction.EscrowFinish{Owner: testAccount(t, "alice"), EscrowSequence: 1, Fulfillment: []byte("milestone 1")}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+
+	unlocked := testTx(t, "alice", 2, transaction.TxTypeEscrowCreate, bobEscrow(t))
+	if err := Apply(st, unlocked, testCloseTime); !errors.Is(err, ErrMalformed) {
+		t.Fatalf("escrow without time or condition: got %v", err)
+	}
+}
+
+func TestEscrowIssued(t *testing.T) {
+	st := testState(t)
+	if err := Fund(st, testAccount(t, "carol"), 1000); err != nil {
+		t.Fatal(err)
+	}
+	addLine(t, st, "alice", "carol", 100, 50)
+	addLine(t, st, "bob", "carol", 100, 0)
+
+	create := testTx(t, "alice", 1, transaction.TxTypeEscrowCreate, bobEscrow(t), func(tx *transaction.EscrowCreate) {
+		tx.Amount = transaction.Amount{Value: amount.FromUint64(30), Currency: "USD", Issuer: testAccount(t, "carol")}
+		tx.FinishAfter = testCloseTime
+	})
+	if err := Apply(st, create, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got := lineBalance(t, st, "alice"); got != 20 {
+		t.Fatalf("got alice line %d, want 20", got)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeEscrowFinish, &transaction.EscrowFinish{Owner: testAccount(t, "alice"), EscrowSequence: 1}), testCloseTime.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if got := lineBalance(t, st, "bob"); got != 30 {
+		t.Fatalf("got bob line %d, want 30", got)
+	}
+}
+
+func TestEscrowIssuedRippling(t *testing.T) {
+	setup := func(t *testing.T) *state.State {
+		st := testState(t)
+		if err := Fund(st, testAccount(t, "carol"), 1000); err != nil {
+			t.Fatal(err)
+		}
+		addLine(t, st, "alice", "carol", 100, 50)
+		addLine(t, st, "bob", "carol", 100, 0)
+		create := testTx(t, "alice", 1, transaction.TxTypeEscrowCreate, bobEscrow(t), func(tx *transaction.EscrowCreate) {
+			tx.Amount = transaction.Amount{Value: amount.FromUint64(30), Currency: "USD", Issuer: testAccount(t, "carol")}
+			tx.FinishAfter = testCloseTime
+		})
+		if err := Apply(st, create, testCloseTime); err != nil {
+			t.Fatal(err)
+		}
+		return st
+	}
+	later := testCloseTime.Add(time.Second)
+
+	t.Run("TransferRate", func(t *testing.T) {
+		st := setup(t)
+		carol, _ := st.Account(testAccount(t, "carol"))
+		carol.TransferRate = 1_500_000_000
+		if err := st.SetAccount(carol); err != nil {
+			t.Fatal(err)
+		}
+
+		// alice pays the fee on release, like a payment of 30 to bob
+		if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeEscrowFinish, &transaction.EscrowFinish{Owner: testAccount(t, "alice"), EscrowSequence: 1}), later); err != nil {
+			t.Fatal(err)
+		}
+		if lineBalance(t, st, "alice") != 5 || lineBalance(t, st, "bob") != 30 {
+			t.Fatalf("got alice %d, bob %d", lineBalance(t, st, "alice"), lineBalance(t, st, "bob"))
+		}
+	})
+
+	t.Run("NoRipple", func(t *testing.T) {
+		st := setup(t)
+		bob, _ := st.Account(testAccount(t, "bob"))
+		bob.TrustLines[0].Flags |= trustline.FlagNoRipple
+		if err := st.SetAccount(bob); err != nil {
+			t.Fatal(err)
+		}
+		if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeEscrowFinish, &transaction.EscrowFinish{Owner: testAccount(t, "alice"), EscrowSequence: 1}), later); !errors.Is(err, ErrNoRipple) {
+			t.Fatalf("got %v", err)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"github.com/ezcon-foundation/go-ezcon/crypto"
+	"github.com/ezcon-foundation/go-ezcon/internal/testkey"
+)
+
+// testCloseTime is the close time of the ledger test transactions go into
+var testCloseTime = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// testKey returns the private key derived from name and the ID of the
+// account it controls
+func testKey(t *testing.T, name string) (crypto.PrivateKey, string) {
+	t.Helper()
+	return testkey.Account(t, name)
+}
+
+func testAccount(t *testing.T, name string) string {
+	_, id := testKey(t, name)
+	return id
+}
+
+// testPubKey returns the encoded public key derived from name
+func testPubKey(t *testing.T, name string) []byte {
+	t.Helper()
+	_, pubKey := testkey.New(t, name)
+	return pubKey
+}
+
+func testState(t *testing.T) *state.State {
+	st := state.New(block.NewSHAMap())
+	if err := st.SetParams(&state.Params{BaseFee: 10}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"alice", "bob"} {
+		if err := Fund(st, testAccount(t, name), 1000); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return st
+}
+
+// testTx fills in the common fields of tx, a transaction of type txType sent
+// by name with sequence seq and a fee of 10, runs edit and signs tx with the
+// key of name
+func testTx[T transaction.Transaction](t *testing.T, name string, seq uint64, txType transaction.TxType, tx T, edit ...func(tx T)) T {
+	t.Helper()
+	priv, acc := testKey(t, name)
+	base := reflect.ValueOf(tx).Elem().FieldByName("BaseTransaction")
+	if !base.IsValid() {
+		t.Fatalf("%T does not embed transaction.BaseTransaction", tx)
+	}
+	base.Set(reflect.ValueOf(transaction.BaseTransaction{TxType: txType.String(), Account: acc, Sequence: seq, Fee: 10}))
+	for _, fn := range edit {
+		fn(tx)
+	}
+	if err := transaction.Sign(tx, priv); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+// tamper changes tx after it was signed
+func tamper[T transaction.Transaction](tx T, edit func(tx T)) T {
+	edit(tx)
+	return tx
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account/kyc"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+type kycSetTransactor struct{}
+
+// Preflight checks that the provider signed KYCHash for the account and,
+// when the transaction carries KYCData, that KYCHash is its hash
+func (kycSetTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.KYCSet)
+	if !ok {
+		return fmt.Errorf("%w: not a KYCSet", ErrMalformed)
+	}
+	if len(t.KYCHash) != sha256.Size {
+		return fmt.Errorf("%w: KYC hash must be %d bytes", ErrMalformed, sha256.Size)
+	}
+
+	if !t.KYCData.IsEmpty() {
+		hash, err := t.KYCData.Hash()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrMalformed, err)
+		}
+		if !bytes.Equal(hash, t.KYCHash) {
+			return ErrKYCHash
+		}
+	}
+
+	providerKey, err := hex.DecodeString(t.KYCProvider)
+	if err != nil || !kyc.Verify(providerKey, t.Account, t.KYCHash, t.KYCSignature) {
+		return ErrKYCSignature
+	}
+	return nil
+}
+
+// Preclaim checks that the provider is recognised by the network
+func (kycSetTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.KYCSet)
+
+	// Preflight has already checked that KYCProvider is valid hex
+	providerKey, _ := hex.DecodeString(t.KYCProvider)
+	if !ctx.Params.IsKYCProvider(address.FromPublicKey(providerKey)) {
+		return ErrUnknownProvider
+	}
+	return nil
+}
+
+// DoApply records the verified KYC hash on the account. The KYC data itself
+// is never stored in the ledger.
+func (kycSetTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.KYCSet)
+	acc := ctx.Account
+
+	acc.KYCHash = t.KYCHash
+	acc.KYCVerified = true
+	acc.KYCTimestamp = ctx.CloseTime
+
+	return ctx.State.SetAccount(acc)
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block/account/kyc"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// kycBy returns alice's KYC data attested by the named provider
+func kycBy(t *testing.T, provider string) *transaction.KYCSet {
+	t.Helper()
+	priv, _ := testKey(t, provider)
+	alice := testAccount(t, "alice")
+	data := kyc.KYCData{FullName: "Alice", IDNumber: "001", Nationality: "VN"}
+	hash, err := data.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &transaction.KYCSet{
+		KYCData:      data,
+		KYCHash:      hash,
+		KYCSignature: kyc.Sign(priv, alice, hash),
+		KYCProvider:  hex.EncodeToString(testPubKey(t, provider)),
+	}
+}
+
+func TestKYCSet(t *testing.T) {
+	st := testState(t)
+	params, err := st.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+	params.KYCProviders = []string{testAccount(t, "provider")}
+	if err := st.SetParams(params); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		tx   *transaction.KYCSet
+		err  error
+	}{
+		{"hash mismatch", testTx(t, "alice", 1, transaction.TxTypeKYCSet, kycBy(t, "provider"), func(tx *transaction.KYCSet) { tx.KYCData.FullName = "Mallory" }), ErrKYCHash},
+		{"signed for another account", testTx(t, "alice", 1, transaction.TxTypeKYCSet, kycBy(t, "provider"), func(tx *transaction.KYCSet) {
+			providerPriv, _ := testKey(t, "provider")
+			tx.KYCSignature = kyc.Sign(providerPriv, testAccount(t, "bob"), tx.KYCHash)
+		}), ErrKYCSignature},
+		{"unknown provider", testTx(t, "alice", 1, transaction.TxTypeKYCSet, kycBy(t, "stranger")), ErrUnknownProvider},
+		{"no hash", testTx(t, "alice", 1, transaction.TxTypeKYCSet, kycBy(t, "provider"), func(tx *transaction.KYCSet) {
+			tx.KYCData, tx.KYCHash = kyc.KYCData{}, nil
+		}), ErrMalformed},
+	}
+	for _, test := range tests {
+		if err := Apply(st, test.tx, testCloseTime); !errors.Is(err, test.err) {
+			t.Fatalf("%s: got %v, want %v", test.name, err, test.err)
+		}
+	}
+
+	tx := testTx(t, "alice", 1, transaction.TxTypeKYCSet, kycBy(t, "provider"))
+	if err := Apply(st, tx, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	acc, err := st.Account(testAccount(t, "alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !acc.KYCVerified || !acc.KYCTimestamp.Equal(testCloseTime) || string(acc.KYCHash) != string(tx.KYCHash) || !acc.KYCData.IsEmpty() {
+		t.Fatalf("got account %+v", acc)
+	}
+
+	// the provider's signature over the hash is enough, the KYC data itself
+	// does not have to be published
+	hash := sha256.Sum256([]byte("bob's KYC data"))
+	providerPriv, _ := testKey(t, "provider")
+	hashOnly := testTx(t, "bob", 1, transaction.TxTypeKYCSet, &transaction.KYCSet{
+		KYCHash:      hash[:],
+		KYCSignature: kyc.Sign(providerPriv, testAccount(t, "bob"), hash[:]),
+		KYCProvider:  hex.EncodeToString(testPubKey(t, "provider")),
+	})
+	if err := Apply(st, hashOnly, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if acc, _ := st.Account(testAccount(t, "bob")); !acc.KYCVerified || string(acc.KYCHash) != string(hash[:]) {
+		t.Fatalf("got account %+v", acc)
+	}
+}
@@ -0,0 +1,375 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/block/offer"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+type offerCreateTransactor struct{}
+
+func (offerCreateTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.OfferCreate)
+	if !ok {
+		return fmt.Errorf("%w: not an OfferCreate", ErrMalformed)
+	}
+	if err := checkAmount(t.TakerPays); err != nil {
+		return err
+	}
+	if err := checkAmount(t.TakerGets); err != nil {
+		return err
+	}
+	if t.TakerPays.Issue() == t.TakerGets.Issue() {
+		return fmt.Errorf("%w: offer exchanges an asset for itself", ErrMalformed)
+	}
+	return nil
+}
+
+// Preclaim checks that the offer can be funded and filled and that neither
+// currency is frozen. The crossing itself is left to DoApply.
+func (offerCreateTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.OfferCreate)
+	if !t.ExpiresAt.IsZero() && !ctx.CloseTime.Before(t.ExpiresAt) {
+		return ErrOfferExpired
+	}
+
+	// the owner must be able to receive what it asks for
+	if pays := t.TakerPays; !pays.IsNative() && pays.Issuer != t.Account {
+		line := findLine(ctx.Account, pays)
+		if line == nil {
+			return ErrNoTrustLine
+		}
+		if !line.IsVerified {
+			return ErrTrustNotConfirmed
+		}
+	}
+
+	for _, a := range []transaction.Amount{t.TakerPays, t.TakerGets} {
+		if a.Issuer == t.Account {
+			continue
+		}
+		frozen, err := isFrozen(ctx.State, a.Issue())
+		if err != nil {
+			return err
+		}
+		if frozen {
+			return ErrFrozen
+		}
+	}
+
+	// the owner must be funded for all it gives, transfer fees included,
+	// once the fee is paid
+	funds, err := available(ctx.State, t.Account, t.TakerGets.Issue())
+	if err != nil {
+		return err
+	}
+	if t.TakerGets.IsNative() {
+		fee := amount.FromUint64(t.Fee)
+		if funds.Cmp(fee) < 0 {
+			return ErrInsufficientFunds
+		}
+		if funds, err = funds.Sub(fee); err != nil {
+			return err
+		}
+	}
+	cost := t.TakerGets.Value
+	if gets := t.TakerGets; !gets.IsNative() && gets.Issuer != t.Account {
+		issuer, err := ctx.State.Account(gets.Issuer)
+		if err != nil {
+			return err
+		}
+		if cost, err = withTransferFee(issuer, cost); err != nil {
+			return err
+		}
+	}
+	if funds.Cmp(cost) < 0 {
+		return ErrInsufficientFunds
+	}
+	return nil
+}
+
+// DoApply crosses the offer against the book and places what is left
+func (offerCreateTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.OfferCreate)
+	return createOffer(ctx.State, t, ctx.CloseTime)
+}
+
+// createOffer crosses t against the opposite book, best quality first, as
+// long as the standing offers are at least as good as the price t asks.
+// Each fill trades at the price of the standing offer. Offers of the owner of
+// t that would cross are cancelled instead of filled. The part of t that is
+// not filled goes on the book at the price of t.
+func createOffer(st *state.State, t *transaction.OfferCreate, closeTime time.Time) error {
+	pays, gets := t.TakerPays.Issue(), t.TakerGets.Issue()
+	taker, err := st.Account(t.Account)
+	if err != nil {
+		return err
+	}
+
+	// the opposite book holds offers whose takers pay what t gives. It is
+	// read one quality at a time, so crossing only loads the offers it meets.
+	wantPays, wantGets := t.TakerPays.Value, t.TakerGets.Value
+	var q offer.Quality
+	for crossing := true; crossing && wantPays.Sign() > 0 && wantGets.Sign() > 0; {
+		next, ok := st.NextQuality(gets, pays, q)
+		if !ok {
+			break
+		}
+		q = next
+		dir, err := st.Directory(gets, pays, q)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < len(dir.Offers) && wantPays.Sign() > 0 && wantGets.Sign() > 0; {
+			ref := dir.Offers[i]
+			o, err := st.Offer(ref.Account, ref.Sequence)
+			if err != nil {
+				return err
+			}
+
+			if !o.ExpiresAt.IsZero() && !closeTime.Before(o.ExpiresAt) {
+				if err := removeOffer(st, dir, o); err != nil {
+					return err
+				}
+				continue
+			}
+			if offer.CompareQuality(o.TakerPays.Value, o.TakerGets.Value, t.TakerGets.Value, t.TakerPays.Value) > 0 {
+				crossing = false
+				break
+			}
+			if o.Account == t.Account {
+				// an account does not trade with itself: its own offer on the
+				// other side is replaced by t
+				if err := removeOffer(st, dir, o); err != nil {
+					return err
+				}
+				continue
+			}
+
+			owner, err := st.Account(o.Account)
+			if err != nil {
+				return err
+			}
+			if checkCounterparties(taker, owner) != nil {
+				// the two accounts may not deal with each other
+				i++
+				continue
+			}
+
+			funds, err := fundsFor(st, o.Account, t.Account, o.TakerGets.Issue())
+			if err != nil {
+				return err
+			}
+			take := amount.Min(wantPays, o.TakerGets.Value, funds)
+			pay, err := exchange(take, o.TakerPays, o.TakerGets, amount.RoundUp)
+			if err != nil {
+				return err
+			}
+			if pay.Cmp(wantGets) > 0 {
+				limit, err := exchange(wantGets, o.TakerGets, o.TakerPays, amount.RoundDown)
+				if err != nil {
+					return err
+				}
+				take = amount.Min(take, limit)
+				if pay, err = exchange(take, o.TakerPays, o.TakerGets, amount.RoundUp); err != nil {
+					return err
+				}
+			}
+			if take.IsZero() {
+				if funds.Sign() > 0 {
+					// what is left of t is too small to trade at this price
+					crossing = false
+					break
+				}
+				// the owner can no longer fund the offer
+				if err := removeOffer(st, dir, o); err != nil {
+					return err
+				}
+				continue
+			}
+
+			// the owner of the standing offer is funded for take, so a failure
+			// there is on the side of t; a failure to pay the owner means the
+			// owner can no longer receive, and its offer is dropped
+			snapshot := st.Snapshot()
+			if err := transfer(st, o.Account, t.Account, o.TakerGets.Issue().Amount(take)); err != nil {
+				return err
+			}
+			if err := transfer(st, t.Account, o.Account, o.TakerPays.Issue().Amount(pay)); err != nil {
+				st.Restore(snapshot)
+				if err := removeOffer(st, dir, o); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if wantPays, err = wantPays.Sub(take); err != nil {
+				return err
+			}
+			if wantGets, err = wantGets.Sub(pay); err != nil {
+				return err
+			}
+			if o.TakerGets.Value, err = o.TakerGets.Value.Sub(take); err != nil {
+				return err
+			}
+			if o.TakerPays.Value, err = o.TakerPays.Value.Sub(pay); err != nil {
+				return err
+			}
+			if o.TakerGets.Value.Sign() <= 0 || o.TakerPays.Value.Sign() <= 0 {
+				if err := removeOffer(st, dir, o); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := st.SetOffer(o); err != nil {
+				return err
+			}
+			i++
+		}
+		if err := st.SetDirectory(gets, pays, q, dir); err != nil {
+			return err
+		}
+	}
+
+	// what is left keeps the price of t
+	if wantPays.Sign() <= 0 || wantGets.Sign() <= 0 {
+		return nil
+	}
+	limit, err := exchange(wantPays, t.TakerGets, t.TakerPays, amount.RoundDown)
+	if err != nil {
+		return err
+	}
+	if wantGets = amount.Min(wantGets, limit); wantGets.IsZero() {
+		return nil
+	}
+	return placeOffer(st, &offer.Offer{
+		Account:   t.Account,
+		Sequence:  t.Sequence,
+		TakerPays: pays.Amount(wantPays),
+		TakerGets: gets.Amount(wantGets),
+		ExpiresAt: t.ExpiresAt,
+	})
+}
+
+// fundsFor returns how much of issue the owner can give to the taker, after
+// the transfer fee it pays when the currency ripples between two holders
+func fundsFor(st *state.State, owner, taker string, issue amount.Issue) (amount.Value, error) {
+	funds, err := available(st, owner, issue)
+	if err != nil || issue.IsNative() || owner == issue.Issuer || taker == issue.Issuer {
+		return funds, err
+	}
+	issuer, err := st.Account(issue.Issuer)
+	if err != nil {
+		return amount.Value{}, err
+	}
+	if issuer.TransferRate <= account.TransferRateParity {
+		return funds, nil
+	}
+	return funds.MulDiv(transferRateParity, amount.FromUint64(uint64(issuer.TransferRate)), amount.RoundDown)
+}
+
+// exchange converts value, counted in the asset of from, into the asset of to
+// at the price to/from. The result is rounded under mode, to whole drops when
+// to is EZC.
+func exchange(value amount.Value, to, from transaction.Amount, mode amount.Rounding) (amount.Value, error) {
+	v, err := value.MulDiv(to.Value, from.Value, mode)
+	if err != nil || !to.IsNative() {
+		return v, err
+	}
+	return v.Integer(mode), nil
+}
+
+// placeOffer stores o at the end of the directory of its quality, after
+// every offer of the same quality placed before it
+func placeOffer(st *state.State, o *offer.Offer) error {
+	pays, gets := o.TakerPays.Issue(), o.TakerGets.Issue()
+	o.Quality = offer.QualityOf(o.TakerPays.Value, o.TakerGets.Value)
+	dir, err := st.Directory(pays, gets, o.Quality)
+	if err != nil {
+		return err
+	}
+	dir.Offers = append(dir.Offers, offer.Ref{Account: o.Account, Sequence: o.Sequence})
+
+	if err := st.SetOffer(o); err != nil {
+		return err
+	}
+	if err := adjustOwnerCount(st, o.Account, 1); err != nil {
+		return err
+	}
+	return st.SetDirectory(pays, gets, o.Quality, dir)
+}
+
+// removeOffer takes o off its directory, deletes it and frees the reserve of
+// its owner. Storing dir is left to the caller.
+func removeOffer(st *state.State, dir *offer.Directory, o *offer.Offer) error {
+	dir.Remove(offer.Ref{Account: o.Account, Sequence: o.Sequence})
+	if err := st.DeleteOffer(o.Account, o.Sequence); err != nil {
+		return err
+	}
+	return adjustOwnerCount(st, o.Account, -1)
+}
+
+type offerCancelTransactor struct{}
+
+func (offerCancelTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.OfferCancel)
+	if !ok {
+		return fmt.Errorf("%w: not an OfferCancel", ErrMalformed)
+	}
+	if t.OfferSequence == 0 {
+		return fmt.Errorf("%w: missing offer sequence", ErrMalformed)
+	}
+	return nil
+}
+
+func (offerCancelTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.OfferCancel)
+	_, err := ctx.State.Offer(t.Account, t.OfferSequence)
+	if errors.Is(err, state.ErrOfferNotFound) {
+		return ErrNoOffer
+	}
+	return err
+}
+
+// DoApply takes the offer off its book
+func (offerCancelTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.OfferCancel)
+	o, err := ctx.State.Offer(t.Account, t.OfferSequence)
+	if err != nil {
+		return err
+	}
+
+	pays, gets := o.TakerPays.Issue(), o.TakerGets.Issue()
+	dir, err := ctx.State.Directory(pays, gets, o.Quality)
+	if err != nil {
+		return err
+	}
+	if err := removeOffer(ctx.State, dir, o); err != nil {
+		return err
+	}
+	return ctx.State.SetDirectory(pays, gets, o.Quality, dir)
+}
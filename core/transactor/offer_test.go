@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/block/offer"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// bookSequences returns the sequences of the offers in the book, in order
+func bookSequences(t *testing.T, st *state.State, pays, gets transaction.Amount) []uint64 {
+	t.Helper()
+	var seqs []uint64
+	var q offer.Quality
+	for {
+		next, ok := st.NextQuality(pays.Issue(), gets.Issue(), q)
+		if !ok {
+			return seqs
+		}
+		q = next
+		dir, err := st.Directory(pays.Issue(), gets.Issue(), q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, ref := range dir.Offers {
+			seqs = append(seqs, ref.Sequence)
+		}
+	}
+}
+
+func TestOfferCrossing(t *testing.T) {
+	st := testState(t)
+	addLine(t, st, "alice", "bob", 1000, 0)
+	ezc := func(v uint64) transaction.Amount { return transaction.Amount{Value: amount.FromUint64(v)} }
+	usd := func(v uint64) transaction.Amount {
+		return transaction.Amount{Value: amount.FromUint64(v), Currency: "USD", Issuer: testAccount(t, "bob")}
+	}
+
+	// bob sells USD for EZC at 2, 3 and 1 EZC per USD
+	for i, o := range []*transaction.OfferCreate{
+		testTx(t, "bob", 1, transaction.TxTypeOfferCreate, &transaction.OfferCreate{TakerPays: ezc(200), TakerGets: usd(100)}),
+		testTx(t, "bob", 2, transaction.TxTypeOfferCreate, &transaction.OfferCreate{TakerPays: ezc(300), TakerGets: usd(100)}),
+		testTx(t, "bob", 3, transaction.TxTypeOfferCreate, &transaction.OfferCreate{TakerPays: ezc(50), TakerGets: usd(50)}),
+	} {
+		if err := Apply(st, o, testCloseTime); err != nil {
+			t.Fatalf("offer %d: %v", i, err)
+		}
+	}
+	if got := bookSequences(t, st, ezc(0), usd(0)); len(got) != 3 || got[0] != 3 || got[1] != 1 || got[2] != 2 {
+		t.Fatalf("got book %v, want [3 1 2]", got)
+	}
+
+	// alice buys 120 USD paying up to 400 EZC: she takes all of offer 3 and
+	// 70 USD of offer 1, each at the price of the standing offer
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypeOfferCreate, &transaction.OfferCreate{TakerPays: usd(120), TakerGets: ezc(400)}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got := lineBalance(t, st, "alice"); got != 120 {
+		t.Fatalf("got alice USD %d, want 120", got)
+	}
+	alice, _ := st.Account(testAccount(t, "alice"))
+	if alice.Balance != 1000-10-50-140 {
+		t.Fatalf("got alice balance %d", alice.Balance)
+	}
+	if got := bookSequences(t, st, ezc(0), usd(0)); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("got book %v, want [1 2]", got)
+	}
+	left, err := st.Offer(testAccount(t, "bob"), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if left.TakerPays.Value != amount.FromUint64(60) || left.TakerGets.Value != amount.FromUint64(30) {
+		t.Fatalf("got partially filled offer %+v", left)
+	}
+	if got := bookSequences(t, st, usd(0), ezc(0)); len(got) != 0 {
+		t.Fatalf("filled offer left on the book: %v", got)
+	}
+
+	// the partially filled offer is still found at the quality it was placed at
+	if err := Apply(st, testTx(t, "bob", 4, transaction.TxTypeOfferCancel, &transaction.OfferCancel{OfferSequence: 1}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got := bookSequences(t, st, ezc(0), usd(0)); len(got) != 1 || got[0] != 2 {
+		t.Fatalf("got book %v, want [2]", got)
+	}
+}
+
+func TestOfferPlaceAndCancel(t *testing.T) {
+	st := testState(t)
+	addLine(t, st, "alice", "bob", 1000, 0)
+	ezc := transaction.Amount{Value: amount.FromUint64(200)}
+	usd := transaction.Amount{Value: amount.FromUint64(500), Currency: "USD", Issuer: testAccount(t, "bob")}
+
+	// bob asks 3 EZC per USD, alice bids 0.4: nothing crosses
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeOfferCreate, &transaction.OfferCreate{TakerPays: transaction.Amount{Value: amount.FromUint64(1500)}, TakerGets: usd}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypeOfferCreate, &transaction.OfferCreate{TakerPays: usd, TakerGets: ezc}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got := bookSequences(t, st, usd, ezc); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("got book %v, want alice's offer", got)
+	}
+
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypeOfferCancel, &transaction.OfferCancel{OfferSequence: 1}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got := bookSequences(t, st, usd, ezc); len(got) != 0 {
+		t.Fatalf("got book %v after cancel", got)
+	}
+	if _, err := st.Offer(testAccount(t, "alice"), 1); !errors.Is(err, state.ErrOfferNotFound) {
+		t.Fatalf("cancelled offer: got %v", err)
+	}
+	if err := Apply(st, testTx(t, "alice", 3, transaction.TxTypeOfferCancel, &transaction.OfferCancel{OfferSequence: 1}), testCloseTime); !errors.Is(err, ErrNoOffer) {
+		t.Fatalf("second cancel: got %v", err)
+	}
+
+	// alice cannot offer USD she does not hold
+	if err := Apply(st, testTx(t, "alice", 3, transaction.TxTypeOfferCreate, &transaction.OfferCreate{TakerPays: ezc, TakerGets: usd}), testCloseTime); !errors.Is(err, ErrInsufficientFunds) {
+		t.Fatalf("unfunded offer: got %v", err)
+	}
+}
+
+func TestOfferSelfCrossing(t *testing.T) {
+	st := testState(t)
+	ezc := transaction.Amount{Value: amount.FromUint64(100)}
+	usd := transaction.Amount{Value: amount.FromUint64(100), Currency: "USD", Issuer: testAccount(t, "bob")}
+
+	// bob sells USD at 1 EZC, then buys it back at the same price: his first
+	// offer is cancelled rather than filled, and nothing changes hands
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeOfferCreate, &transaction.OfferCreate{TakerPays: ezc, TakerGets: usd}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "bob", 2, transaction.TxTypeOfferCreate, &transaction.OfferCreate{TakerPays: usd, TakerGets: ezc}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := bookSequences(t, st, ezc, usd); len(got) != 0 {
+		t.Fatalf("got book %v, want the crossed offer cancelled", got)
+	}
+	if got := bookSequences(t, st, usd, ezc); len(got) != 1 || got[0] != 2 {
+		t.Fatalf("got book %v, want the new offer placed", got)
+	}
+	if bal, owned := balance(t, st, "bob"); bal != 1000-20 || owned != 1 {
+		t.Fatalf("got balance %d and owner count %d", bal, owned)
+	}
+}
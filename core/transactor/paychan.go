@@ -0,0 +1,283 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/block/channel"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+)
+
+type paymentChannelCreateTransactor struct{}
+
+func (paymentChannelCreateTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.PaymentChannelCreate)
+	if !ok {
+		return fmt.Errorf("%w: not a PaymentChannelCreate", ErrMalformed)
+	}
+	if err := address.ValidateAccount(t.Destination); err != nil {
+		return fmt.Errorf("%w: destination: %v", ErrMalformed, err)
+	}
+	if t.Destination == t.Account {
+		return fmt.Errorf("%w: channel to self", ErrMalformed)
+	}
+	if err := checkChannelAmount(t.Amount); err != nil {
+		return err
+	}
+	pubKey, err := hex.DecodeString(t.PublicKey)
+	if err != nil || hex.EncodeToString(pubKey) != t.PublicKey {
+		return fmt.Errorf("%w: public key is not lower case hex", ErrMalformed)
+	}
+	if _, err := keys.ParsePublicKey(pubKey); err != nil {
+		return fmt.Errorf("%w: public key: %v", ErrMalformed, err)
+	}
+	return nil
+}
+
+// Preclaim checks the destination and that the account can fund the
+// channel after the fee
+func (paymentChannelCreateTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.PaymentChannelCreate)
+	dest, err := ctx.State.Account(t.Destination)
+	if errors.Is(err, state.ErrAccountNotFound) {
+		return ErrNoDestination
+	}
+	if err != nil {
+		return err
+	}
+	if dest.HasFlag(account.FlagRequireDestTag) && t.DestinationTag == 0 {
+		return ErrDestTagRequired
+	}
+	if err := checkCounterparties(ctx.Account, dest); err != nil {
+		return err
+	}
+	if !t.CancelAfter.IsZero() && !ctx.CloseTime.Before(t.CancelAfter) {
+		return ErrChannelExpired
+	}
+	if ctx.Account.Balance-t.Fee < drops(t.Amount) {
+		return ErrInsufficientFunds
+	}
+	return nil
+}
+
+// DoApply takes the amount from the account into a new channel
+func (paymentChannelCreateTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.PaymentChannelCreate)
+	if err := debitNative(ctx.State, t.Account, drops(t.Amount)); err != nil {
+		return err
+	}
+	if err := adjustOwnerCount(ctx.State, t.Account, 1); err != nil {
+		return err
+	}
+	return ctx.State.SetChannel(&channel.Channel{
+		Account:     t.Account,
+		Sequence:    t.Sequence,
+		Destination: t.Destination,
+		Amount:      drops(t.Amount),
+		PublicKey:   t.PublicKey,
+		SettleDelay: t.SettleDelay,
+		CancelAfter: t.CancelAfter,
+	})
+}
+
+type paymentChannelFundTransactor struct{}
+
+func (paymentChannelFundTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.PaymentChannelFund)
+	if !ok {
+		return fmt.Errorf("%w: not a PaymentChannelFund", ErrMalformed)
+	}
+	if t.ChannelSequence == 0 {
+		return fmt.Errorf("%w: missing channel sequence", ErrMalformed)
+	}
+	return checkChannelAmount(t.Amount)
+}
+
+// Preclaim checks that the account's channel is still open, that a new
+// expiration respects the settle delay, and that the amount is covered
+func (paymentChannelFundTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.PaymentChannelFund)
+	ch, err := loadChannel(ctx.State, t.Account, t.ChannelSequence)
+	if err != nil {
+		return err
+	}
+	if ch.Expired(ctx.CloseTime) {
+		return ErrChannelExpired
+	}
+	if !t.Expiration.IsZero() && t.Expiration.Before(ch.SettleTime(ctx.CloseTime)) {
+		return ErrBadExpiration
+	}
+	if ctx.Account.Balance-t.Fee < drops(t.Amount) {
+		return ErrInsufficientFunds
+	}
+	return nil
+}
+
+// DoApply adds the amount to the channel
+func (paymentChannelFundTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.PaymentChannelFund)
+	ch, err := ctx.State.Channel(t.Account, t.ChannelSequence)
+	if err != nil {
+		return err
+	}
+	if err := debitNative(ctx.State, t.Account, drops(t.Amount)); err != nil {
+		return err
+	}
+	if ch.Amount+drops(t.Amount) < ch.Amount {
+		return fmt.Errorf("%w: channel amount overflow", ErrMalformed)
+	}
+	ch.Amount += drops(t.Amount)
+	if !t.Expiration.IsZero() {
+		ch.Expiration = t.Expiration
+	}
+	return ctx.State.SetChannel(ch)
+}
+
+type paymentChannelClaimTransactor struct{}
+
+func (paymentChannelClaimTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.PaymentChannelClaim)
+	if !ok {
+		return fmt.Errorf("%w: not a PaymentChannelClaim", ErrMalformed)
+	}
+	if err := address.ValidateAccount(t.Owner); err != nil {
+		return fmt.Errorf("%w: owner: %v", ErrMalformed, err)
+	}
+	if t.ChannelSequence == 0 {
+		return fmt.Errorf("%w: missing channel sequence", ErrMalformed)
+	}
+	if t.Balance == 0 && !t.Close {
+		return fmt.Errorf("%w: claim neither pays nor closes", ErrMalformed)
+	}
+	if t.ClaimSignature != "" && t.Balance > t.Amount {
+		return fmt.Errorf("%w: balance above the signed amount", ErrMalformed)
+	}
+	return nil
+}
+
+// Preclaim checks that the account is a party to the channel and, unless
+// the channel has expired, that the new balance is covered by the channel
+// and, when the destination claims it, by a claim the channel key signed
+func (paymentChannelClaimTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.PaymentChannelClaim)
+	ch, err := loadChannel(ctx.State, t.Owner, t.ChannelSequence)
+	if err != nil {
+		return err
+	}
+	if t.Account != ch.Account && t.Account != ch.Destination {
+		return ErrNotChannelParty
+	}
+	if ch.Expired(ctx.CloseTime) || t.Balance == 0 {
+		return nil
+	}
+
+	if t.Balance <= ch.Balance {
+		return ErrBadClaim
+	}
+	if t.Balance > ch.Amount {
+		return ErrInsufficientFunds
+	}
+	if t.Account == ch.Destination {
+		claim := channel.Claim{NetworkID: ctx.Params.NetworkID, Account: ch.Account, Sequence: ch.Sequence, Amount: t.Amount}
+		if !claim.Verify(ch.PublicKey, t.ClaimSignature) {
+			return ErrBadClaim
+		}
+	}
+	return nil
+}
+
+// DoApply pays the destination up to the new balance and handles a close
+// request. An expired channel is closed whatever the claim asked.
+func (paymentChannelClaimTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.PaymentChannelClaim)
+	ch, err := ctx.State.Channel(t.Owner, t.ChannelSequence)
+	if err != nil {
+		return err
+	}
+	if ch.Expired(ctx.CloseTime) {
+		return closeChannel(ctx.State, ch)
+	}
+
+	if t.Balance != 0 {
+		if err := Fund(ctx.State, ch.Destination, t.Balance-ch.Balance); err != nil {
+			return err
+		}
+		ch.Balance = t.Balance
+	}
+	if t.Close {
+		if t.Account == ch.Destination || ch.Remaining() == 0 {
+			return closeChannel(ctx.State, ch)
+		}
+		if settle := ch.SettleTime(ctx.CloseTime); ch.Expiration.IsZero() || settle.Before(ch.Expiration) {
+			ch.Expiration = settle
+		}
+	}
+	return ctx.State.SetChannel(ch)
+}
+
+// checkChannelAmount checks that amount is a positive native amount, the only
+// kind a channel holds
+func checkChannelAmount(amount transaction.Amount) error {
+	if err := checkAmount(amount); err != nil {
+		return err
+	}
+	if !amount.IsNative() {
+		return fmt.Errorf("%w: channels hold %s only", ErrMalformed, NativeCurrency)
+	}
+	return nil
+}
+
+func loadChannel(st *state.State, owner string, sequence uint64) (*channel.Channel, error) {
+	ch, err := st.Channel(owner, sequence)
+	if errors.Is(err, state.ErrChannelNotFound) {
+		return nil, ErrNoChannel
+	}
+	return ch, err
+}
+
+// debitNative takes drops from the account balance
+func debitNative(st *state.State, accountID string, drops uint64) error {
+	acc, err := st.Account(accountID)
+	if err != nil {
+		return err
+	}
+	if acc.Balance < drops {
+		return ErrInsufficientFunds
+	}
+	acc.Balance -= drops
+	return st.SetAccount(acc)
+}
+
+// closeChannel returns what the destination has not claimed to the owner
+// and deletes the channel
+func closeChannel(st *state.State, ch *channel.Channel) error {
+	if err := Fund(st, ch.Account, ch.Remaining()); err != nil {
+		return err
+	}
+	if err := st.DeleteChannel(ch.Account, ch.Sequence); err != nil {
+		return err
+	}
+	return adjustOwnerCount(st, ch.Account, -1)
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/block/channel"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// bobChannel returns a channel of 300 drops to bob, with claims signed by the
+// "alice channel" key and an hour of settle delay
+func bobChannel(t *testing.T) *transaction.PaymentChannelCreate {
+	return &transaction.PaymentChannelCreate{
+		Destination: testAccount(t, "bob"),
+		Amount:      transaction.Amount{Value: amount.FromUint64(300)},
+		SettleDelay: 3600,
+		PublicKey:   hex.EncodeToString(testPubKey(t, "alice channel")),
+	}
+}
+
+// claimSignature returns signer's signature over a claim of amount drops on
+// alice's channel channelSeq
+func claimSignature(t *testing.T, signer string, channelSeq, amount uint64) string {
+	t.Helper()
+	priv, _ := testKey(t, signer)
+	sig, err := channel.Claim{Account: testAccount(t, "alice"), Sequence: channelSeq, Amount: amount}.Sign(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+func TestPaymentChannelClaims(t *testing.T) {
+	st := testState(t)
+	if err := Fund(st, testAccount(t, "carol"), 1000); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePaymentChannelCreate, bobChannel(t)), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got, owned := balance(t, st, "alice"); got != 690 || owned != 1 {
+		t.Fatalf("got alice balance %d owning %d", got, owned)
+	}
+
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 100, Amount: 100, ClaimSignature: claimSignature(t, "alice channel", 1, 100)}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := balance(t, st, "bob"); got != 1000-10+100 {
+		t.Fatalf("got bob balance %d", got)
+	}
+
+	cases := []struct {
+		name string
+		tx   transaction.Transaction
+		want error
+	}{
+		{"replayed claim", testTx(t, "bob", 2, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 100, Amount: 100, ClaimSignature: claimSignature(t, "alice channel", 1, 100)}), ErrBadClaim},
+		{"balance above claim", testTx(t, "bob", 2, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 150, Amount: 120, ClaimSignature: claimSignature(t, "alice channel", 1, 120)}), ErrMalformed},
+		{"unsigned claim", testTx(t, "bob", 2, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 150}), ErrBadClaim},
+		{"wrong key", testTx(t, "bob", 2, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 150, Amount: 150, ClaimSignature: claimSignature(t, "alice", 1, 150)}), ErrBadClaim},
+		{"above channel amount", testTx(t, "bob", 2, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 400, Amount: 400, ClaimSignature: claimSignature(t, "alice channel", 1, 400)}), ErrInsufficientFunds},
+		{"outsider", testTx(t, "carol", 1, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 150, Amount: 150, ClaimSignature: claimSignature(t, "alice channel", 1, 150)}), ErrNotChannelParty},
+		{"unknown channel", testTx(t, "bob", 2, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 9, Balance: 150, Amount: 150, ClaimSignature: claimSignature(t, "alice channel", 9, 150)}), ErrNoChannel},
+	}
+	for _, c := range cases {
+		if err := Apply(st, c.tx, testCloseTime); !errors.Is(err, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, err, c.want)
+		}
+	}
+
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypePaymentChannelFund, &transaction.PaymentChannelFund{ChannelSequence: 1, Amount: transaction.Amount{Value: amount.FromUint64(100)}}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "bob", 2, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 400, Amount: 400, ClaimSignature: claimSignature(t, "alice channel", 1, 400)}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := balance(t, st, "bob"); got != 1000-20+400 {
+		t.Fatalf("got bob balance %d", got)
+	}
+}
+
+func TestPaymentChannelClose(t *testing.T) {
+	st := testState(t)
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePaymentChannelCreate, bobChannel(t)), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypePaymentChannelFund, &transaction.PaymentChannelFund{ChannelSequence: 1, Amount: transaction.Amount{Value: amount.FromUint64(100)}, Expiration: testCloseTime.Add(time.Minute)}), testCloseTime); !errors.Is(err, ErrBadExpiration) {
+		t.Fatalf("expiration within settle delay: got %v", err)
+	}
+
+	// the owner's close waits for the settle delay
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Close: true}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	ch, err := st.Channel(testAccount(t, "alice"), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ch.Expiration.Equal(testCloseTime.Add(time.Hour)) {
+		t.Fatalf("got expiration %v", ch.Expiration)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 200, Amount: 200, ClaimSignature: claimSignature(t, "alice channel", 1, 200)}), testCloseTime.Add(30*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	// once expired, the channel closes and its rest returns to the owner
+	late := testCloseTime.Add(2 * time.Hour)
+	if err := Apply(st, testTx(t, "alice", 3, transaction.TxTypePaymentChannelFund, &transaction.PaymentChannelFund{ChannelSequence: 1, Amount: transaction.Amount{Value: amount.FromUint64(100)}}), late); !errors.Is(err, ErrChannelExpired) {
+		t.Fatalf("fund after expiration: got %v", err)
+	}
+	if err := Apply(st, testTx(t, "bob", 2, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 250, Amount: 250, ClaimSignature: claimSignature(t, "alice channel", 1, 250)}), late); err != nil {
+		t.Fatal(err)
+	}
+	if got, owned := balance(t, st, "alice"); got != 1000-20-300+100 || owned != 0 {
+		t.Fatalf("got alice balance %d owning %d", got, owned)
+	}
+	if got, _ := balance(t, st, "bob"); got != 1000-20+200 {
+		t.Fatalf("got bob balance %d", got)
+	}
+	if err := Apply(st, testTx(t, "bob", 3, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 300, Amount: 300, ClaimSignature: claimSignature(t, "alice channel", 1, 300)}), late); !errors.Is(err, ErrNoChannel) {
+		t.Fatalf("claim on closed channel: got %v", err)
+	}
+}
+
+func TestPaymentChannelDestinationClose(t *testing.T) {
+	st := testState(t)
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePaymentChannelCreate, bobChannel(t)), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypePaymentChannelClaim, &transaction.PaymentChannelClaim{Owner: testAccount(t, "alice"), ChannelSequence: 1, Balance: 50, Amount: 50, ClaimSignature: claimSignature(t, "alice channel", 1, 50), Close: true}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got, owned := balance(t, st, "alice"); got != 1000-10-50 || owned != 0 {
+		t.Fatalf("got alice balance %d owning %d", got, owned)
+	}
+	if got, _ := balance(t, st, "bob"); got != 1000-10+50 {
+		t.Fatalf("got bob balance %d", got)
+	}
+}
+
+func TestPaymentChannelDestinationTag(t *testing.T) {
+	st := testState(t)
+	setFlags(t, st, "bob", account.FlagRequireDestTag)
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePaymentChannelCreate, bobChannel(t)), testCloseTime); !errors.Is(err, ErrDestTagRequired) {
+		t.Fatalf("got %v", err)
+	}
+
+	tx := testTx(t, "alice", 1, transaction.TxTypePaymentChannelCreate, bobChannel(t), func(tx *transaction.PaymentChannelCreate) {
+		tx.DestinationTag = 7
+	})
+	if err := Apply(st, tx, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+}
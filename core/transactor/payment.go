@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+type paymentTransactor struct{}
+
+func (paymentTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.Payment)
+	if !ok {
+		return fmt.Errorf("%w: not a Payment", ErrMalformed)
+	}
+	if err := address.ValidateAccount(t.Destination); err != nil {
+		return fmt.Errorf("%w: destination: %v", ErrMalformed, err)
+	}
+	if t.Destination == t.Account {
+		return fmt.Errorf("%w: payment to self", ErrMalformed)
+	}
+	return checkAmount(t.Amount)
+}
+
+// Preclaim checks the settings of the destination, then runs the transfer on
+// a copy of the state, after the fee, so that a payment passing Check moves
+// the funds when applied
+func (paymentTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.Payment)
+
+	dest, err := ctx.State.Account(t.Destination)
+	switch {
+	case err == nil:
+		if dest.HasFlag(account.FlagRequireDestTag) && t.DestinationTag == 0 {
+			return ErrDestTagRequired
+		}
+		if err := checkCounterparties(ctx.Account, dest); err != nil {
+			return err
+		}
+	case errors.Is(err, state.ErrAccountNotFound):
+		// a new account is never KYC verified
+		if ctx.Account.HasFlag(account.FlagRequireKYCForCounterparties) {
+			return ErrKYCRequired
+		}
+
+		// a new account is created with at least its base reserve
+		if t.Amount.IsNative() && drops(t.Amount) < ctx.Params.BaseReserve {
+			return ErrInsufficientReserve
+		}
+	default:
+		return err
+	}
+
+	scratch := state.New(ctx.State.Tree())
+	acc := *ctx.Account
+	acc.Balance -= t.Fee
+	if err := scratch.SetAccount(&acc); err != nil {
+		return err
+	}
+	return transfer(scratch, t.Account, t.Destination, t.Amount)
+}
+
+// DoApply moves the amount to the destination
+func (paymentTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.Payment)
+	return transfer(ctx.State, t.Account, t.Destination, t.Amount)
+}
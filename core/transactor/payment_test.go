@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account/trustline"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// addLine gives holder a confirmed trust line toward issuer
+func addLine(t *testing.T, st *state.State, holder, issuer string, limit uint64, balance int64) {
+	t.Helper()
+	acc, err := st.Account(testAccount(t, holder))
+	if err != nil {
+		t.Fatal(err)
+	}
+	acc.TrustLines = append(acc.TrustLines, trustline.TrustLine{
+		Account: testAccount(t, issuer), Currency: "USD", Limit: amount.FromUint64(limit), Balance: value(balance), IsVerified: true,
+	})
+	if err := st.SetAccount(acc); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func lineBalance(t *testing.T, st *state.State, holder string) int64 {
+	t.Helper()
+	acc, err := st.Account(testAccount(t, holder))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(acc.TrustLines) != 1 {
+		t.Fatalf("%s has %d trust lines", holder, len(acc.TrustLines))
+	}
+	b := acc.TrustLines[0].Balance
+	v, ok := b.Abs().Uint64()
+	if !ok {
+		t.Fatalf("%s holds %s, not a whole number", holder, b)
+	}
+	if b.Sign() < 0 {
+		return -int64(v)
+	}
+	return int64(v)
+}
+
+// value returns v as an amount.Value
+func value(v int64) amount.Value {
+	if v < 0 {
+		return amount.FromUint64(uint64(-v)).Neg()
+	}
+	return amount.FromUint64(uint64(v))
+}
+
+func TestNativePayment(t *testing.T) {
+	st := testState(t)
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "carol"), Amount: transaction.Amount{Value: amount.FromUint64(300)}}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+
+	alice, _ := st.Account(testAccount(t, "alice"))
+	carol, err := st.Account(testAccount(t, "carol"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alice.Balance != 690 || carol.Balance != 300 || carol.Sequence != 1 {
+		t.Fatalf("got alice %d, carol %+v", alice.Balance, carol)
+	}
+
+	// the fee is taken before the amount
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: transaction.Amount{Value: amount.FromUint64(690)}}), testCloseTime); !errors.Is(err, ErrInsufficientFunds) {
+		t.Fatalf("overspend: got %v", err)
+	}
+}
+
+func TestIssuedPayment(t *testing.T) {
+	usd := func(v uint64) transaction.Amount {
+		return transaction.Amount{Value: amount.FromUint64(v), Currency: "USD", Issuer: testAccount(t, "alice")}
+	}
+
+	st := testState(t)
+	if err := Fund(st, testAccount(t, "carol"), 1000); err != nil {
+		t.Fatal(err)
+	}
+	addLine(t, st, "bob", "alice", 100, 0)
+	addLine(t, st, "carol", "alice", 50, 0)
+
+	// issuer to holder, within the limit
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: usd(80)}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: usd(30)}), testCloseTime); !errors.Is(err, ErrTrustLimit) {
+		t.Fatalf("over the limit: got %v", err)
+	}
+
+	// holder to holder ripples through the issuer
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "carol"), Amount: usd(50)}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if lineBalance(t, st, "bob") != 30 || lineBalance(t, st, "carol") != 50 {
+		t.Fatalf("got bob %d, carol %d", lineBalance(t, st, "bob"), lineBalance(t, st, "carol"))
+	}
+	if err := Apply(st, testTx(t, "bob", 2, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "carol"), Amount: usd(1)}), testCloseTime); !errors.Is(err, ErrTrustLimit) {
+		t.Fatalf("over the receiver limit: got %v", err)
+	}
+	if err := Apply(st, testTx(t, "bob", 2, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "carol"), Amount: usd(31)}), testCloseTime); !errors.Is(err, ErrInsufficientFunds) {
+		t.Fatalf("over the sender balance: got %v", err)
+	}
+
+	// holder back to the issuer
+	if err := Apply(st, testTx(t, "carol", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "alice"), Amount: usd(50)}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if lineBalance(t, st, "carol") != 0 {
+		t.Fatal("redeemed balance still on the line")
+	}
+
+	// no trust line toward the issuer
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "dave"), Amount: usd(1)}), testCloseTime); !errors.Is(err, ErrNoDestination) {
+		t.Fatalf("unknown holder: got %v", err)
+	}
+	if err := Fund(st, testAccount(t, "dave"), 1000); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "dave"), Amount: usd(1)}), testCloseTime); !errors.Is(err, ErrNoTrustLine) {
+		t.Fatalf("no trust line: got %v", err)
+	}
+}
+
+func TestFractionalPayment(t *testing.T) {
+	usd := func(s string) transaction.Amount {
+		v, err := amount.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return transaction.Amount{Value: v, Currency: "USD", Issuer: testAccount(t, "alice")}
+	}
+	st := testState(t)
+	if err := Fund(st, testAccount(t, "carol"), 1000); err != nil {
+		t.Fatal(err)
+	}
+	addLine(t, st, "bob", "alice", 100, 0)
+	addLine(t, st, "carol", "alice", 100, 0)
+
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: usd("12.345")}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "carol"), Amount: usd("0.005")}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	for holder, want := range map[string]string{"bob": "12.34", "carol": "0.005"} {
+		acc, err := st.Account(testAccount(t, holder))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := acc.TrustLines[0].Balance.String(); got != want {
+			t.Errorf("%s holds %s, want %s", holder, got, want)
+		}
+	}
+
+	// EZC only moves in whole drops
+	half, err := amount.Parse("0.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "bob", 2, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "carol"), Amount: transaction.Amount{Value: half}}), testCloseTime); !errors.Is(err, ErrMalformed) {
+		t.Fatalf("half a drop: got %v", err)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// withReserves returns the test state with a base reserve of 200 drops and
+// an owner reserve of 400 drops
+func withReserves(t *testing.T) *state.State {
+	t.Helper()
+	st := testState(t)
+	if err := st.SetParams(&state.Params{BaseFee: 10, BaseReserve: 200, OwnerReserve: 400}); err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+func TestReservePayment(t *testing.T) {
+	st := withReserves(t)
+	ezc := func(v uint64) transaction.Amount { return transaction.Amount{Value: amount.FromUint64(v)} }
+
+	// 990 left after the fee, and alice must keep 200
+	tx := testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: ezc(791)})
+	if err := Check(st, tx, testCloseTime); !errors.Is(err, ErrInsufficientReserve) {
+		t.Fatalf("check: got %v", err)
+	}
+	if err := Apply(st, tx, testCloseTime); !errors.Is(err, ErrInsufficientReserve) {
+		t.Fatalf("apply: got %v", err)
+	}
+	if got, _ := balance(t, st, "alice"); got != 1000 {
+		t.Fatalf("failed payment left alice with %d", got)
+	}
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: ezc(790)}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+
+	// the fee alone may eat into the reserve
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypeAccountSet, &transaction.AccountSet{}, func(tx *transaction.AccountSet) {
+		tx.Domain = "alice.example"
+	}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+
+	// a new account gets at least the base reserve
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "carol"), Amount: ezc(199)}), testCloseTime); !errors.Is(err, ErrInsufficientReserve) {
+		t.Fatalf("new account: got %v", err)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "carol"), Amount: ezc(200)}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReserveOwnerCount(t *testing.T) {
+	st := withReserves(t)
+
+	// one object needs 600 drops, two need 1000
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t)), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got, owned := balance(t, st, "alice"); got != 990 || owned != 1 {
+		t.Fatalf("got alice balance %d owning %d", got, owned)
+	}
+	escrow := func(seq uint64) *transaction.EscrowCreate {
+		return testTx(t, "alice", seq, transaction.TxTypeEscrowCreate, &transaction.EscrowCreate{
+			Destination: testAccount(t, "bob"),
+			Amount:      transaction.Amount{Value: amount.FromUint64(100)},
+			FinishAfter: testCloseTime,
+		})
+	}
+	if err := Apply(st, escrow(2), testCloseTime); !errors.Is(err, ErrInsufficientReserve) {
+		t.Fatalf("second object: got %v", err)
+	}
+
+	// removing the pending line frees its reserve
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) {
+		tx.Limit = amount.Value{}
+	}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if _, owned := balance(t, st, "alice"); owned != 0 {
+		t.Fatalf("alice still owns %d objects", owned)
+	}
+	if err := Apply(st, escrow(3), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got, owned := balance(t, st, "alice"); got != 870 || owned != 1 {
+		t.Fatalf("got alice balance %d owning %d", got, owned)
+	}
+}
+
+func TestReserveOffer(t *testing.T) {
+	st := withReserves(t)
+	addLine(t, st, "alice", "bob", 1000, 0)
+	ezc := func(v uint64) transaction.Amount { return transaction.Amount{Value: amount.FromUint64(v)} }
+	usd := func(v uint64) transaction.Amount {
+		return transaction.Amount{Value: amount.FromUint64(v), Currency: "USD", Issuer: testAccount(t, "bob")}
+	}
+
+	// the reserve of alice cannot fund the offer
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypeOfferCreate, &transaction.OfferCreate{TakerPays: usd(10), TakerGets: ezc(791)}), testCloseTime); !errors.Is(err, ErrInsufficientFunds) {
+		t.Fatalf("offer beyond the reserve: got %v", err)
+	}
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypeOfferCreate, &transaction.OfferCreate{TakerPays: usd(10), TakerGets: ezc(100)}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if _, owned := balance(t, st, "alice"); owned != 1 {
+		t.Fatalf("alice owns %d objects", owned)
+	}
+	if err := Apply(st, testTx(t, "alice", 2, transaction.TxTypeOfferCancel, &transaction.OfferCancel{OfferSequence: 1}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if _, owned := balance(t, st, "alice"); owned != 0 {
+		t.Fatalf("alice still owns %d objects after the cancel", owned)
+	}
+}
+
+func TestReserveConfirmedLine(t *testing.T) {
+	st := withReserves(t)
+	usd := transaction.Amount{Value: amount.FromUint64(50), Currency: "USD", Issuer: testAccount(t, "bob")}
+	zeroLimit := func(seq uint64) *transaction.TrustSet {
+		return testTx(t, "alice", seq, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) {
+			tx.Limit = amount.Value{}
+		})
+	}
+
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t)), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeTrustConfirm, &transaction.TrustConfirm{Destination: testAccount(t, "alice"), Currency: "USD"}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "bob", 2, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "alice"), Amount: usd}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+
+	// a confirmed line holding a balance is kept with a zero limit
+	if err := Apply(st, zeroLimit(2), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if _, owned := balance(t, st, "alice"); owned != 1 {
+		t.Fatalf("alice owns %d objects", owned)
+	}
+
+	// once the balance is paid back the line can be deleted
+	if err := Apply(st, testTx(t, "alice", 3, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: usd}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, zeroLimit(4), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	acc, err := st.Account(testAccount(t, "alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(acc.TrustLines) != 0 || acc.OwnerCount != 0 {
+		t.Fatalf("got %d trust lines owning %d objects", len(acc.TrustLines), acc.OwnerCount)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+type signerListSetTransactor struct{}
+
+func (signerListSetTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.SignerListSet)
+	if !ok {
+		return fmt.Errorf("%w: not a SignerListSet", ErrMalformed)
+	}
+	if t.SignerQuorum == 0 && len(t.SignerEntries) == 0 {
+		return nil
+	}
+	if t.SignerQuorum == 0 {
+		return fmt.Errorf("%w: zero quorum", ErrMalformed)
+	}
+	if len(t.SignerEntries) == 0 || len(t.SignerEntries) > account.MaxSignerEntries {
+		return fmt.Errorf("%w: signer list needs 1 to %d entries", ErrMalformed, account.MaxSignerEntries)
+	}
+
+	seen := make(map[string]bool, len(t.SignerEntries))
+	var total uint64
+	for _, entry := range t.SignerEntries {
+		if err := address.ValidateAccount(entry.Account); err != nil {
+			return fmt.Errorf("%w: signer: %v", ErrMalformed, err)
+		}
+		if entry.Account == t.Account {
+			return fmt.Errorf("%w: account in its own signer list", ErrMalformed)
+		}
+		if seen[entry.Account] {
+			return fmt.Errorf("%w: duplicate signer %s", ErrMalformed, entry.Account)
+		}
+		if entry.Weight == 0 {
+			return fmt.Errorf("%w: zero weight for %s", ErrMalformed, entry.Account)
+		}
+		seen[entry.Account] = true
+		total += uint64(entry.Weight)
+	}
+	if total < uint64(t.SignerQuorum) {
+		return fmt.Errorf("%w: quorum %d above the total weight %d", ErrMalformed, t.SignerQuorum, total)
+	}
+	return nil
+}
+
+// Preclaim keeps an account whose master key is disabled from removing its
+// signer list
+func (signerListSetTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.SignerListSet)
+	if t.SignerQuorum != 0 {
+		return nil
+	}
+	if ctx.Account.SignerQuorum == 0 {
+		return ErrNoSignerList
+	}
+	if ctx.Account.HasFlag(account.FlagDisableMaster) {
+		return ErrNoAlternativeKey
+	}
+	return nil
+}
+
+// DoApply replaces the signer list, kept sorted by account
+func (signerListSetTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.SignerListSet)
+	acc := ctx.Account
+
+	// the signer list is one object, whatever the number of its entries
+	switch {
+	case acc.SignerQuorum == 0 && t.SignerQuorum != 0:
+		if err := addOwnerCount(acc, 1); err != nil {
+			return err
+		}
+	case acc.SignerQuorum != 0 && t.SignerQuorum == 0:
+		if err := addOwnerCount(acc, -1); err != nil {
+			return err
+		}
+	}
+
+	acc.SignerQuorum = t.SignerQuorum
+	acc.SignerEntries = append([]account.SignerEntry(nil), t.SignerEntries...)
+	sort.Slice(acc.SignerEntries, func(i, j int) bool {
+		return acc.SignerEntries[i].Account < acc.SignerEntries[j].Account
+	})
+
+	return ctx.State.SetAccount(acc)
+}
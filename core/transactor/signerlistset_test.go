@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// multiSign replaces the signature of tx with signatures of the named accounts
+func multiSign(t *testing.T, tx transaction.Transaction, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		priv, _ := testKey(t, name)
+		if err := transaction.MultiSign(tx, priv); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// multiSignedPayment returns an unsigned payment of 5 drops from alice to bob
+// with the fee of n signatures, multi-signed by names
+func multiSignedPayment(t *testing.T, seq uint64, names ...string) *transaction.Payment {
+	t.Helper()
+	tx := &transaction.Payment{
+		BaseTransaction: transaction.BaseTransaction{
+			TxType: "Payment", Account: testAccount(t, "alice"), Sequence: seq, Fee: 10 * uint64(1+len(names)),
+		},
+		Destination: testAccount(t, "bob"),
+		Amount:      transaction.Amount{Value: amount.FromUint64(5)},
+	}
+	multiSign(t, tx, names...)
+	return tx
+}
+
+// withSignerList gives alice the signer list bob:1, carol:1, dave:2 with a
+// quorum of 2
+func withSignerList(t *testing.T) *state.State {
+	t.Helper()
+	st := testState(t)
+	tx := testTx(t, "alice", 1, transaction.TxTypeSignerListSet, &transaction.SignerListSet{
+		SignerQuorum: 2,
+		SignerEntries: []account.SignerEntry{
+			{Account: testAccount(t, "dave"), Weight: 2},
+			{Account: testAccount(t, "bob"), Weight: 1},
+			{Account: testAccount(t, "carol"), Weight: 1},
+		},
+	})
+	if err := Apply(st, tx, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+func TestMultiSign(t *testing.T) {
+	st := withSignerList(t)
+
+	unsorted := multiSignedPayment(t, 2, "bob", "carol")
+	unsorted.Signers[0], unsorted.Signers[1] = unsorted.Signers[1], unsorted.Signers[0]
+	lowFee := multiSignedPayment(t, 2, "bob", "carol")
+	lowFee.Fee = 20
+	multiSign(t, lowFee, "bob", "carol")
+
+	tests := []struct {
+		name string
+		tx   *transaction.Payment
+		err  error
+	}{
+		{"below quorum", multiSignedPayment(t, 2, "bob"), ErrBadQuorum},
+		{"not in the list", multiSignedPayment(t, 2, "bob", "eve"), ErrBadSigner},
+		{"unsorted", unsorted, ErrMalformed},
+		{"fee for one signature", lowFee, ErrFeeTooLow},
+	}
+	for _, test := range tests {
+		if err := Apply(st, test.tx, testCloseTime); !errors.Is(err, test.err) {
+			t.Fatalf("%s: got %v, want %v", test.name, err, test.err)
+		}
+	}
+
+	for seq, signers := range [][]string{{"bob", "carol"}, {"dave"}} {
+		if err := Apply(st, multiSignedPayment(t, uint64(seq+2), signers...), testCloseTime); err != nil {
+			t.Fatalf("signed by %v: %v", signers, err)
+		}
+	}
+}
+
+func TestDisableMaster(t *testing.T) {
+	disable := func(name string, seq uint64) *transaction.AccountSet {
+		return testTx(t, name, seq, transaction.TxTypeAccountSet, &transaction.AccountSet{}, func(tx *transaction.AccountSet) { tx.SetFlags = account.FlagDisableMaster })
+	}
+
+	st := testState(t)
+	if err := Apply(st, disable("alice", 1), testCloseTime); !errors.Is(err, ErrNoAlternativeKey) {
+		t.Fatalf("without a signer list: got %v", err)
+	}
+
+	st = withSignerList(t)
+	if err := Apply(st, disable("alice", 2), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "alice", 3, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "bob"), Amount: transaction.Amount{Value: amount.FromUint64(5)}}), testCloseTime); !errors.Is(err, ErrMasterDisabled) {
+		t.Fatalf("master signed: got %v", err)
+	}
+
+	remove := &transaction.SignerListSet{
+		BaseTransaction: transaction.BaseTransaction{TxType: "SignerListSet", Account: testAccount(t, "alice"), Sequence: 3, Fee: 20},
+	}
+	multiSign(t, remove, "dave")
+	if err := Apply(st, remove, testCloseTime); !errors.Is(err, ErrNoAlternativeKey) {
+		t.Fatalf("removing the last way to sign: got %v", err)
+	}
+	if err := Apply(st, multiSignedPayment(t, 3, "dave"), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,329 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// Transactor implements the rules of one transaction type. The work shared by
+// every type (sequence, fee, signature, account lookup) is done by Apply, so a
+// transactor only deals with what is specific to its type.
+type Transactor interface {
+	// Preflight checks the transaction on its own, without looking at the ledger
+	Preflight(tx transaction.Transaction) error
+
+	// Preclaim checks the transaction against the state before anything is changed
+	Preclaim(ctx *Context) error
+
+	// DoApply makes the state changes of the transaction. The fee has already
+	// been claimed and the sequence consumed on ctx.Account.
+	DoApply(ctx *Context) error
+}
+
+// Context is the transaction being applied and the state it is applied to
+type Context struct {
+	State   *state.State
+	Tx      transaction.Transaction
+	Account *account.Account
+	Params  *state.Params
+
+	// CloseTime is the close time of the ledger the transaction goes into
+	CloseTime time.Time
+}
+
+// transactors maps each supported transaction type to its rules
+var transactors = map[transaction.TxType]Transactor{
+	transaction.TxTypeKYCSet:               kycSetTransactor{},
+	transaction.TxTypePayment:              paymentTransactor{},
+	transaction.TxTypeTrustSet:             trustSetTransactor{},
+	transaction.TxTypeTrustConfirm:         trustConfirmTransactor{},
+	transaction.TxTypeOfferCreate:          offerCreateTransactor{},
+	transaction.TxTypeOfferCancel:          offerCancelTransactor{},
+	transaction.TxTypeAccountSet:           accountSetTransactor{},
+	transaction.TxTypeSignerListSet:        signerListSetTransactor{},
+	transaction.TxTypeEscrowCreate:         escrowCreateTransactor{},
+	transaction.TxTypeEscrowFinish:         escrowFinishTransactor{},
+	transaction.TxTypeEscrowCancel:         escrowCancelTransactor{},
+	transaction.TxTypePaymentChannelCreate: paymentChannelCreateTransactor{},
+	transaction.TxTypePaymentChannelFund:   paymentChannelFundTransactor{},
+	transaction.TxTypePaymentChannelClaim:  paymentChannelClaimTransactor{},
+}
+
+func lookup(tx transaction.Transaction) (Transactor, error) {
+	t, ok := transactors[tx.GetTxType()]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedTxType, tx.GetTxType())
+	}
+	return t, nil
+}
+
+// Preflight runs the stateless checks of the transaction for the network
+// networkID
+func Preflight(tx transaction.Transaction, networkID uint32) error {
+	t, err := lookup(tx)
+	if err != nil {
+		return err
+	}
+	if tx.GetNetworkID() != networkID {
+		return fmt.Errorf("%w: got %d, want %d", ErrWrongNetwork, tx.GetNetworkID(), networkID)
+	}
+	if err := address.ValidateAccount(tx.GetAccount()); err != nil {
+		return fmt.Errorf("%w: account: %v", ErrMalformed, err)
+	}
+	if err := checkSignature(tx); err != nil {
+		return err
+	}
+	return t.Preflight(tx)
+}
+
+// Check runs every check of the transaction against the state without
+// changing it. The transaction is applied to a copy of the state, so a
+// transaction passing Check is expected to apply in a ledger closing at
+// closeTime.
+func Check(st *state.State, tx transaction.Transaction, closeTime time.Time) error {
+	return Apply(state.New(st.Tree()), tx, closeTime)
+}
+
+// Apply checks the transaction and applies it to the state of a ledger
+// closing at closeTime. Either every change is made or, when an error is
+// returned, the state is left untouched.
+func Apply(st *state.State, tx transaction.Transaction, closeTime time.Time) error {
+	t, err := lookup(tx)
+	if err != nil {
+		return err
+	}
+	params, err := loadParams(st)
+	if err != nil {
+		return err
+	}
+	if err := Preflight(tx, params.NetworkID); err != nil {
+		return err
+	}
+	ctx, err := newContext(st, tx, params, closeTime)
+	if err != nil {
+		return err
+	}
+	if err := t.Preclaim(ctx); err != nil {
+		return err
+	}
+
+	snapshot := st.Snapshot()
+
+	ctx.Account.Balance -= tx.GetFee()
+	ctx.Account.Sequence++
+	if err := st.SetAccount(ctx.Account); err != nil {
+		st.Restore(snapshot)
+		return err
+	}
+
+	balance, ownerCount := ctx.Account.Balance, ctx.Account.OwnerCount
+	if err := t.DoApply(ctx); err != nil {
+		st.Restore(snapshot)
+		return err
+	}
+	if err := checkReserve(st, ctx.Params, tx.GetAccount(), balance, ownerCount); err != nil {
+		st.Restore(snapshot)
+		return err
+	}
+	return nil
+}
+
+// checkReserve fails a transaction that spends from the sending account or
+// makes it own more objects when the account is left below its reserve.
+// balance and ownerCount are those of the account after the fee was claimed,
+// so the fee alone may eat into the reserve.
+func checkReserve(st *state.State, params *state.Params, accountID string, balance uint64, ownerCount uint32) error {
+	acc, err := st.Account(accountID)
+	if err != nil {
+		return err
+	}
+	if acc.Balance >= balance && acc.OwnerCount <= ownerCount {
+		return nil
+	}
+	if acc.Balance < params.Reserve(acc.OwnerCount) {
+		return ErrInsufficientReserve
+	}
+	return nil
+}
+
+// newContext loads the sending account and runs the state checks shared by
+// every transaction type
+func newContext(st *state.State, tx transaction.Transaction, params *state.Params, closeTime time.Time) (*Context, error) {
+	acc, err := st.Account(tx.GetAccount())
+	if errors.Is(err, state.ErrAccountNotFound) {
+		return nil, ErrNoAccount
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.GetSequence() != uint64(acc.Sequence) {
+		return nil, fmt.Errorf("%w: got %d, want %d", ErrBadSequence, tx.GetSequence(), acc.Sequence)
+	}
+	if err := checkAuthorization(acc, tx); err != nil {
+		return nil, err
+	}
+	// every signature of a multi-signed transaction costs a base fee
+	if tx.GetFee() < params.BaseFee*uint64(1+len(tx.GetSigners())) {
+		return nil, ErrFeeTooLow
+	}
+	if acc.Balance < tx.GetFee() {
+		return nil, ErrInsufficientFee
+	}
+
+	return &Context{State: st, Tx: tx, Account: acc, Params: params, CloseTime: closeTime}, nil
+}
+
+// loadParams loads the network parameters, which are all zero in a state
+// without any
+func loadParams(st *state.State) (*state.Params, error) {
+	params, err := st.Params()
+	if errors.Is(err, state.ErrParamsNotFound) {
+		return &state.Params{}, nil
+	}
+	return params, err
+}
+
+// checkSignature makes sure the transaction is signed by the key that
+// controls its account, or by the signers of a multi-signed transaction
+func checkSignature(tx transaction.Transaction) error {
+	if len(tx.GetSigners()) > 0 {
+		return checkSigners(tx)
+	}
+	if tx.GetSignature() == "" || tx.GetSigningPubKey() == "" {
+		return ErrMissingSignature
+	}
+	if !transaction.VerifySignature(tx) {
+		return ErrBadSignature
+	}
+
+	// VerifySignature has already checked that SigningPubKey is valid hex
+	pubKey, _ := hex.DecodeString(tx.GetSigningPubKey())
+	if address.FromPublicKey(pubKey) != tx.GetAccount() {
+		return ErrBadSigner
+	}
+	return nil
+}
+
+// checkSigners verifies every signature of a multi-signed transaction and
+// that each comes from the key controlling its signer account. Whether the
+// signers may sign for the account is left to checkAuthorization.
+func checkSigners(tx transaction.Transaction) error {
+	if tx.GetSignature() != "" || tx.GetSigningPubKey() != "" {
+		return fmt.Errorf("%w: both single and multi-signed", ErrMalformed)
+	}
+	signers := tx.GetSigners()
+	if len(signers) > account.MaxSignerEntries {
+		return fmt.Errorf("%w: too many signers", ErrMalformed)
+	}
+	for i, s := range signers {
+		if i > 0 && s.Account <= signers[i-1].Account {
+			return fmt.Errorf("%w: signers not sorted by account", ErrMalformed)
+		}
+		if s.Account == tx.GetAccount() {
+			return fmt.Errorf("%w: account signs for itself", ErrMalformed)
+		}
+		if !transaction.VerifySigner(tx, s) {
+			return ErrBadSignature
+		}
+		pubKey, _ := hex.DecodeString(s.SigningPubKey)
+		if address.FromPublicKey(pubKey) != s.Account {
+			return ErrBadSigner
+		}
+	}
+	return nil
+}
+
+// checkAuthorization checks that the transaction is signed in a way acc
+// accepts: with its master key unless that is disabled, or by signers of its
+// signer list whose weights reach the quorum
+func checkAuthorization(acc *account.Account, tx transaction.Transaction) error {
+	signers := tx.GetSigners()
+	if len(signers) == 0 {
+		if acc.HasFlag(account.FlagDisableMaster) {
+			return ErrMasterDisabled
+		}
+		return nil
+	}
+
+	if acc.SignerQuorum == 0 {
+		return ErrNoSignerList
+	}
+	var weight uint64
+	for _, s := range signers {
+		w := acc.SignerWeight(s.Account)
+		if w == 0 {
+			return ErrBadSigner
+		}
+		weight += uint64(w)
+	}
+	if weight < uint64(acc.SignerQuorum) {
+		return ErrBadQuorum
+	}
+	return nil
+}
+
+// Fund credits native drops to an account, creating the account with
+// sequence 1 the first time it is funded
+func Fund(st *state.State, accountID string, drops uint64) error {
+	acc, err := st.Account(accountID)
+	if errors.Is(err, state.ErrAccountNotFound) {
+		acc = &account.Account{AccountID: accountID, Sequence: 1}
+	} else if err != nil {
+		return err
+	}
+
+	if acc.Balance+drops < acc.Balance {
+		return fmt.Errorf("%w: balance overflow", ErrMalformed)
+	}
+	acc.Balance += drops
+	return st.SetAccount(acc)
+}
+
+// adjustOwnerCount changes the number of ledger objects the account owns
+func adjustOwnerCount(st *state.State, accountID string, delta int) error {
+	acc, err := st.Account(accountID)
+	if err != nil {
+		return err
+	}
+	if err := addOwnerCount(acc, delta); err != nil {
+		return err
+	}
+	return st.SetAccount(acc)
+}
+
+// addOwnerCount changes the owner count of an account that is stored by the
+// caller
+func addOwnerCount(acc *account.Account, delta int) error {
+	count := int64(acc.OwnerCount) + int64(delta)
+	if count < 0 || count > math.MaxUint32 {
+		return fmt.Errorf("%w: owner count out of range", ErrMalformed)
+	}
+	acc.OwnerCount = uint32(count)
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// usdLine returns an unsigned trust line of 100 USD toward bob
+func usdLine(t *testing.T) *transaction.TrustSet {
+	return &transaction.TrustSet{Destination: testAccount(t, "bob"), Currency: "USD", Limit: amount.FromUint64(100)}
+}
+
+func TestApply(t *testing.T) {
+	st := testState(t)
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t)), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+
+	acc, err := st.Account(testAccount(t, "alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acc.Balance != 990 || acc.Sequence != 2 || len(acc.TrustLines) != 1 {
+		t.Fatalf("got account %+v", acc)
+	}
+
+	// updating the line keeps a single entry
+	update := testTx(t, "alice", 2, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) { tx.Limit = amount.FromUint64(500) })
+	if err := Apply(st, update, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	acc, _ = st.Account(testAccount(t, "alice"))
+	if len(acc.TrustLines) != 1 || acc.TrustLines[0].Limit != amount.FromUint64(500) {
+		t.Fatalf("got trust lines %+v", acc.TrustLines)
+	}
+}
+
+func TestApplyFailureLeavesState(t *testing.T) {
+	tests := []struct {
+		name string
+		tx   *transaction.TrustSet
+		err  error
+	}{
+		{"bad sequence", testTx(t, "alice", 3, transaction.TxTypeTrustSet, usdLine(t)), ErrBadSequence},
+		{"low fee", testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) { tx.Fee = 1 }), ErrFeeTooLow},
+		{"fee above balance", testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) { tx.Fee = 5000 }), ErrInsufficientFee},
+		{"unsigned", tamper(testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t)), func(tx *transaction.TrustSet) { tx.Signature = "" }), ErrMissingSignature},
+		{"tampered", tamper(testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t)), func(tx *transaction.TrustSet) { tx.Limit = amount.FromUint64(1000) }), ErrBadSignature},
+		{"upper case signature", tamper(testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t)), func(tx *transaction.TrustSet) { tx.Signature = strings.ToUpper(tx.Signature) }), ErrBadSignature},
+		{"foreign key", testTx(t, "bob", 1, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) { tx.Account = testAccount(t, "alice") }), ErrBadSigner},
+		{"other network", testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) { tx.NetworkID = 2 }), ErrWrongNetwork},
+		{"unknown destination", testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) { tx.Destination = testAccount(t, "carol") }), ErrNoDestination},
+		{"native currency", testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) { tx.Currency = NativeCurrency }), ErrMalformed},
+	}
+
+	for _, test := range tests {
+		st := testState(t)
+		before := st.Tree().RootHash()
+		if err := Apply(st, test.tx, testCloseTime); !errors.Is(err, test.err) {
+			t.Fatalf("%s: got %v, want %v", test.name, err, test.err)
+		}
+		if !bytes.Equal(before, st.Tree().RootHash()) {
+			t.Fatalf("%s: failed transaction changed the state", test.name)
+		}
+	}
+}
+
+func TestFund(t *testing.T) {
+	st := testState(t)
+	if err := Fund(st, "carol", 50); err != nil {
+		t.Fatal(err)
+	}
+	acc, err := st.Account("carol")
+	if err != nil || acc.Balance != 50 || acc.Sequence != 1 {
+		t.Fatalf("got %+v %v", acc, err)
+	}
+}
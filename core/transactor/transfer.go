@@ -0,0 +1,248 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account/trustline"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// transfer moves an amount from one account to another. Native amounts move
+// drops between balances and create the receiving account if needed. Issued
+// amounts move along the trust lines that holders keep toward the issuer:
+// paying the issuer lowers the sender's line, receiving from the issuer
+// raises the receiver's line, and a payment between two holders ripples
+// through the issuer. Only lines the issuer has confirmed carry value.
+//
+// Rippling is refused while the issuer has frozen its currencies or when
+// either line is marked NoRipple, and costs the sender the transfer fee of
+// the issuer on top of amount.
+func transfer(st *state.State, from, to string, amount transaction.Amount) error {
+	if amount.IsNative() {
+		return transferNative(st, from, to, drops(amount))
+	}
+
+	if from != amount.Issuer && to != amount.Issuer {
+		issuer, err := st.Account(amount.Issuer)
+		if err != nil {
+			return err
+		}
+		if issuer.HasFlag(account.FlagGlobalFreeze) {
+			return ErrFrozen
+		}
+		cost, err := withTransferFee(issuer, amount.Value)
+		if err != nil {
+			return err
+		}
+		if err := debitLine(st, from, amount, cost, true); err != nil {
+			return err
+		}
+		return creditLine(st, to, amount, true)
+	}
+
+	if from != amount.Issuer {
+		if err := debitLine(st, from, amount, amount.Value, false); err != nil {
+			return err
+		}
+	}
+	if to != amount.Issuer {
+		if err := creditLine(st, to, amount, false); err != nil {
+			return err
+		}
+	} else if !st.HasAccount(to) {
+		return ErrNoDestination
+	}
+	return nil
+}
+
+// withTransferFee returns what a holder pays for value to ripple through the
+// issuer, rounded up
+func withTransferFee(issuer *account.Account, value amount.Value) (amount.Value, error) {
+	if issuer.TransferRate <= account.TransferRateParity {
+		return value, nil
+	}
+	return value.MulDiv(amount.FromUint64(uint64(issuer.TransferRate)), transferRateParity, amount.RoundUp)
+}
+
+// transferRateParity is account.TransferRateParity as a Value
+var transferRateParity = amount.FromUint64(uint64(account.TransferRateParity))
+
+func transferNative(st *state.State, from, to string, drops uint64) error {
+	src, err := st.Account(from)
+	if err != nil {
+		return err
+	}
+	if src.Balance < drops {
+		return ErrInsufficientFunds
+	}
+	src.Balance -= drops
+	if err := st.SetAccount(src); err != nil {
+		return err
+	}
+	return Fund(st, to, drops)
+}
+
+// drops returns the value of a native amount that passed checkAmount
+func drops(amount transaction.Amount) uint64 {
+	v, _ := amount.Value.Uint64()
+	return v
+}
+
+// checkAmount validates a positive amount of EZC or of an issued currency.
+// EZC moves in whole drops.
+func checkAmount(amount transaction.Amount) error {
+	if amount.Value.Sign() <= 0 {
+		return fmt.Errorf("%w: amount must be positive", ErrMalformed)
+	}
+	if amount.IsNative() {
+		if _, ok := amount.Value.Uint64(); !ok {
+			return fmt.Errorf("%w: %s amount is not a whole number of drops", ErrMalformed, NativeCurrency)
+		}
+	} else {
+		if amount.Currency == "" || amount.Currency == NativeCurrency {
+			return fmt.Errorf("%w: invalid currency %q", ErrMalformed, amount.Currency)
+		}
+		if err := address.ValidateAccount(amount.Issuer); err != nil {
+			return fmt.Errorf("%w: issuer: %v", ErrMalformed, err)
+		}
+	}
+	return nil
+}
+
+// available returns how much of issue the account holds and can give away.
+// An issuer can always issue more of its own currency, nothing of a frozen
+// currency can be given away by its holders, and EZC held for the reserve of
+// the account cannot be given away.
+func available(st *state.State, accountID string, issue amount.Issue) (amount.Value, error) {
+	if !issue.IsNative() && accountID == issue.Issuer {
+		return amount.MaxValue, nil
+	}
+	acc, err := st.Account(accountID)
+	if err != nil {
+		return amount.Value{}, err
+	}
+	if issue.IsNative() {
+		params, err := loadParams(st)
+		if err != nil {
+			return amount.Value{}, err
+		}
+		reserve := params.Reserve(acc.OwnerCount)
+		if acc.Balance <= reserve {
+			return amount.Value{}, nil
+		}
+		return amount.FromUint64(acc.Balance - reserve), nil
+	}
+
+	if frozen, err := isFrozen(st, issue); err != nil || frozen {
+		return amount.Value{}, err
+	}
+	line := findTrustLine(acc, issue.Issuer, issue.Currency)
+	if line == nil || !line.IsVerified || line.Balance.Sign() <= 0 {
+		return amount.Value{}, nil
+	}
+	return line.Balance, nil
+}
+
+// isFrozen reports whether the issuer of issue has frozen its currencies
+func isFrozen(st *state.State, issue amount.Issue) (bool, error) {
+	if issue.IsNative() {
+		return false, nil
+	}
+	issuer, err := st.Account(issue.Issuer)
+	if errors.Is(err, state.ErrAccountNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return issuer.HasFlag(account.FlagGlobalFreeze), nil
+}
+
+// findLine returns the trust line the holder keeps toward the issuer of amount
+func findLine(acc *account.Account, amount transaction.Amount) *trustline.TrustLine {
+	return findTrustLine(acc, amount.Issuer, amount.Currency)
+}
+
+// debitLine takes value off the balance the holder has on its trust line for
+// amount. A rippling debit needs a line that allows rippling.
+func debitLine(st *state.State, holder string, amount transaction.Amount, value amount.Value, rippling bool) error {
+	acc, err := st.Account(holder)
+	if err != nil {
+		return err
+	}
+	line := findLine(acc, amount)
+	if line == nil {
+		return ErrNoTrustLine
+	}
+	if !line.IsVerified {
+		return ErrTrustNotConfirmed
+	}
+	if rippling && line.Flags&trustline.FlagNoRipple != 0 {
+		return ErrNoRipple
+	}
+	if line.Balance.Cmp(value) < 0 {
+		return ErrInsufficientFunds
+	}
+	if line.Balance, err = line.Balance.Sub(value); err != nil {
+		return err
+	}
+	return st.SetAccount(acc)
+}
+
+// creditLine adds amount to the holder's trust line, within its limit. A
+// rippling credit needs a line that allows rippling.
+func creditLine(st *state.State, holder string, amount transaction.Amount, rippling bool) error {
+	acc, err := st.Account(holder)
+	if errors.Is(err, state.ErrAccountNotFound) {
+		return ErrNoDestination
+	}
+	if err != nil {
+		return err
+	}
+	line := findLine(acc, amount)
+	if line == nil {
+		return ErrNoTrustLine
+	}
+	if !line.IsVerified {
+		return ErrTrustNotConfirmed
+	}
+	if rippling && line.Flags&trustline.FlagNoRipple != 0 {
+		return ErrNoRipple
+	}
+
+	held := amount.Value
+	if line.Balance.Sign() > 0 {
+		if held, err = held.Add(line.Balance); err != nil {
+			return ErrTrustLimit
+		}
+	}
+	if held.Cmp(line.Limit) > 0 {
+		return ErrTrustLimit
+	}
+	if line.Balance, err = line.Balance.Add(amount.Value); err != nil {
+		return err
+	}
+	return st.SetAccount(acc)
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+type trustConfirmTransactor struct{}
+
+func (trustConfirmTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.TrustConfirm)
+	if !ok {
+		return fmt.Errorf("%w: not a TrustConfirm", ErrMalformed)
+	}
+	if err := address.ValidateAccount(t.Destination); err != nil {
+		return fmt.Errorf("%w: destination: %v", ErrMalformed, err)
+	}
+	if t.Destination == t.Account {
+		return fmt.Errorf("%w: invalid destination", ErrMalformed)
+	}
+	if t.Currency == "" || t.Currency == NativeCurrency {
+		return fmt.Errorf("%w: invalid currency %q", ErrMalformed, t.Currency)
+	}
+	return nil
+}
+
+// Preclaim checks that the destination has a pending, unexpired line toward
+// the sender
+func (trustConfirmTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.TrustConfirm)
+	holder, err := ctx.State.Account(t.Destination)
+	if errors.Is(err, state.ErrAccountNotFound) {
+		return ErrNoDestination
+	}
+	if err != nil {
+		return err
+	}
+
+	line := findTrustLine(holder, t.Account, t.Currency)
+	if line == nil {
+		return ErrNoTrustLine
+	}
+	if line.IsVerified {
+		return ErrTrustConfirmed
+	}
+	if !line.ExpiresAt.IsZero() && !ctx.CloseTime.Before(line.ExpiresAt) {
+		return ErrTrustExpired
+	}
+	return checkCounterparties(ctx.Account, holder)
+}
+
+// DoApply marks the destination's line as mutually confirmed
+func (trustConfirmTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.TrustConfirm)
+	holder, err := ctx.State.Account(t.Destination)
+	if err != nil {
+		return err
+	}
+	findTrustLine(holder, t.Account, t.Currency).IsVerified = true
+	return ctx.State.SetAccount(holder)
+}
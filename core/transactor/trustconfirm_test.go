@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+func TestTrustConfirm(t *testing.T) {
+	st := testState(t)
+	usd := transaction.Amount{Value: amount.FromUint64(50), Currency: "USD", Issuer: testAccount(t, "bob")}
+
+	if err := Apply(st, testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t)), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "alice"), Amount: usd}), testCloseTime); !errors.Is(err, ErrTrustNotConfirmed) {
+		t.Fatalf("payment over pending line: got %v", err)
+	}
+
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeTrustConfirm, &transaction.TrustConfirm{Destination: testAccount(t, "alice"), Currency: "USD"}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "bob", 2, transaction.TxTypeTrustConfirm, &transaction.TrustConfirm{Destination: testAccount(t, "alice"), Currency: "USD"}), testCloseTime); !errors.Is(err, ErrTrustConfirmed) {
+		t.Fatalf("second confirm: got %v", err)
+	}
+	if err := Apply(st, testTx(t, "bob", 2, transaction.TxTypePayment, &transaction.Payment{Destination: testAccount(t, "alice"), Amount: usd}), testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if got := lineBalance(t, st, "alice"); got != 50 {
+		t.Fatalf("got balance %d, want 50", got)
+	}
+}
+
+func TestTrustConfirmPendingOffer(t *testing.T) {
+	st := testState(t)
+
+	// the offer expires at the close time of the ledger
+	expiring := testTx(t, "alice", 1, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) { tx.ExpiresAt = testCloseTime })
+	if err := Apply(st, expiring, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeTrustConfirm, &transaction.TrustConfirm{Destination: testAccount(t, "alice"), Currency: "USD"}), testCloseTime); !errors.Is(err, ErrTrustExpired) {
+		t.Fatalf("confirm expired offer: got %v", err)
+	}
+
+	// a zero limit withdraws the pending offer
+	cancel := testTx(t, "alice", 2, transaction.TxTypeTrustSet, usdLine(t), func(tx *transaction.TrustSet) { tx.Limit = amount.FromUint64(0) })
+	if err := Apply(st, cancel, testCloseTime); err != nil {
+		t.Fatal(err)
+	}
+	acc, err := st.Account(testAccount(t, "alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(acc.TrustLines) != 0 {
+		t.Fatalf("got trust lines %+v", acc.TrustLines)
+	}
+	if err := Apply(st, testTx(t, "bob", 1, transaction.TxTypeTrustConfirm, &transaction.TrustConfirm{Destination: testAccount(t, "alice"), Currency: "USD"}), testCloseTime); !errors.Is(err, ErrNoTrustLine) {
+		t.Fatalf("confirm withdrawn offer: got %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package transactor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account"
+	"github.com/ezcon-foundation/go-ezcon/core/block/account/trustline"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+)
+
+// NativeCurrency is the currency code of EZC, which cannot be held on a trust line
+const NativeCurrency = transaction.NativeCurrency
+
+type trustSetTransactor struct{}
+
+func (trustSetTransactor) Preflight(tx transaction.Transaction) error {
+	t, ok := tx.(*transaction.TrustSet)
+	if !ok {
+		return fmt.Errorf("%w: not a TrustSet", ErrMalformed)
+	}
+	if err := address.ValidateAccount(t.Destination); err != nil {
+		return fmt.Errorf("%w: destination: %v", ErrMalformed, err)
+	}
+	if t.Destination == t.Account {
+		return fmt.Errorf("%w: invalid destination", ErrMalformed)
+	}
+	if t.Currency == "" || t.Currency == NativeCurrency {
+		return fmt.Errorf("%w: invalid currency %q", ErrMalformed, t.Currency)
+	}
+	if t.Limit.Sign() < 0 {
+		return fmt.Errorf("%w: negative limit", ErrMalformed)
+	}
+	return nil
+}
+
+func (trustSetTransactor) Preclaim(ctx *Context) error {
+	t := ctx.Tx.(*transaction.TrustSet)
+	issuer, err := ctx.State.Account(t.Destination)
+	if errors.Is(err, state.ErrAccountNotFound) {
+		return ErrNoDestination
+	}
+	if err != nil {
+		return err
+	}
+
+	if findTrustLine(ctx.Account, t.Destination, t.Currency) == nil {
+		if t.Limit.IsZero() {
+			return ErrNoTrustLine
+		}
+		if issuer.HasFlag(account.FlagDisallowIncomingTrustlines) {
+			return ErrTrustDisallowed
+		}
+	}
+	return checkCounterparties(ctx.Account, issuer)
+}
+
+// DoApply creates or updates the trust line toward the destination. A new
+// line stays pending until the destination confirms it with TrustConfirm, and
+// does not ripple unless the destination has DefaultRipple. A zero limit on a
+// pending line, or on a confirmed line with a zero balance, deletes the line
+// and frees its reserve. Raising the limit toward a destination with
+// RequireAuth needs a new confirmation.
+func (trustSetTransactor) DoApply(ctx *Context) error {
+	t := ctx.Tx.(*transaction.TrustSet)
+	acc := ctx.Account
+	issuer, err := ctx.State.Account(t.Destination)
+	if err != nil {
+		return err
+	}
+
+	line := findTrustLine(acc, t.Destination, t.Currency)
+	switch {
+	case line == nil:
+		var flags uint32
+		if !issuer.HasFlag(account.FlagDefaultRipple) {
+			flags |= trustline.FlagNoRipple
+		}
+		acc.TrustLines = append(acc.TrustLines, trustline.TrustLine{
+			Account:    t.Destination,
+			Currency:   t.Currency,
+			Limit:      t.Limit,
+			Flags:      flags,
+			Conditions: t.Conditions,
+			ExpiresAt:  t.ExpiresAt,
+		})
+		if err := addOwnerCount(acc, 1); err != nil {
+			return err
+		}
+	case t.Limit.IsZero() && (!line.IsVerified || line.Balance.IsZero()):
+		removeTrustLine(acc, line)
+		if err := addOwnerCount(acc, -1); err != nil {
+			return err
+		}
+	default:
+		if t.Limit.Cmp(line.Limit) > 0 && issuer.HasFlag(account.FlagRequireAuth) {
+			line.IsVerified = false
+		}
+		line.Limit = t.Limit
+		line.Conditions = t.Conditions
+		line.ExpiresAt = t.ExpiresAt
+	}
+
+	return ctx.State.SetAccount(acc)
+}
+
+// findTrustLine returns the line the account keeps toward counterparty for currency
+func findTrustLine(acc *account.Account, counterparty, currency string) *trustline.TrustLine {
+	for i := range acc.TrustLines {
+		line := &acc.TrustLines[i]
+		if line.Account == counterparty && line.Currency == currency {
+			return line
+		}
+	}
+	return nil
+}
+
+// removeTrustLine deletes line, which must point into acc.TrustLines
+func removeTrustLine(acc *account.Account, line *trustline.TrustLine) {
+	for i := range acc.TrustLines {
+		if &acc.TrustLines[i] == line {
+			acc.TrustLines = append(acc.TrustLines[:i], acc.TrustLines[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package txpool holds the transactions waiting to be included in a ledger
+package txpool
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"github.com/ezcon-foundation/go-ezcon/core/transactor"
+)
+
+// DefaultMaxSize is the pool size used when none is configured
+const DefaultMaxSize = 10000
+
+var (
+	ErrDuplicate     = errors.New("txpool: transaction already in the pool")
+	ErrSequenceTaken = errors.New("txpool: sequence already used by a transaction with an equal or higher fee")
+	ErrPoolFull      = errors.New("txpool: pool is full and the fee is too low to evict a transaction")
+)
+
+// accountSeq identifies the slot of a transaction: an account can only have
+// one pending transaction per sequence
+type accountSeq struct {
+	account  string
+	sequence uint64
+}
+
+type entry struct {
+	tx      transaction.Transaction
+	id      block.Key
+	slot    accountSeq
+	arrival uint64
+}
+
+// before reports whether e comes before o in fee order: higher fee first,
+// then earlier arrival
+func (e *entry) before(o *entry) bool {
+	if e.tx.GetFee() != o.tx.GetFee() {
+		return e.tx.GetFee() > o.tx.GetFee()
+	}
+	return e.arrival < o.arrival
+}
+
+// Pool is a bounded, thread-safe set of pending transactions keyed by
+// account and sequence. When full, the transaction with the lowest fee is
+// evicted to make room for a better paying one.
+type Pool struct {
+	mutex   sync.Mutex
+	maxSize int
+	byID    map[block.Key]*entry
+	bySlot  map[accountSeq]*entry
+	arrival uint64
+}
+
+// New returns an empty pool holding at most maxSize transactions
+func New(maxSize int) *Pool {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	return &Pool{
+		maxSize: maxSize,
+		byID:    make(map[block.Key]*entry),
+		bySlot:  make(map[accountSeq]*entry),
+	}
+}
+
+// Add puts a transaction in the pool and returns its ID. A transaction for
+// an account and sequence already in the pool replaces it only if it pays a
+// higher fee.
+func (p *Pool) Add(tx transaction.Transaction) (block.Key, error) {
+	id, err := transaction.ID(tx)
+	if err != nil {
+		return block.Key{}, err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, ok := p.byID[id]; ok {
+		return id, ErrDuplicate
+	}
+
+	p.arrival++
+	e := &entry{
+		tx:      tx,
+		id:      id,
+		slot:    accountSeq{account: tx.GetAccount(), sequence: tx.GetSequence()},
+		arrival: p.arrival,
+	}
+
+	if old, ok := p.bySlot[e.slot]; ok {
+		if tx.GetFee() <= old.tx.GetFee() {
+			return id, ErrSequenceTaken
+		}
+		p.remove(old)
+	} else if len(p.byID) >= p.maxSize {
+		worst := p.worst()
+		if !e.before(worst) {
+			return id, ErrPoolFull
+		}
+		p.remove(worst)
+	}
+
+	p.byID[id] = e
+	p.bySlot[e.slot] = e
+	return id, nil
+}
+
+// worst returns the entry to evict first. Caller must hold p.mutex
+func (p *Pool) worst() *entry {
+	var worst *entry
+	for _, e := range p.byID {
+		if worst == nil || worst.before(e) {
+			worst = e
+		}
+	}
+	return worst
+}
+
+// remove drops an entry. Caller must hold p.mutex
+func (p *Pool) remove(e *entry) {
+	delete(p.byID, e.id)
+	delete(p.bySlot, e.slot)
+}
+
+// Len returns the number of pending transactions
+func (p *Pool) Len() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return len(p.byID)
+}
+
+// Has reports whether the transaction with the given ID is pending
+func (p *Pool) Has(id block.Key) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	_, ok := p.byID[id]
+	return ok
+}
+
+// Transactions returns the pending transactions, highest fee first
+func (p *Pool) Transactions() []transaction.Transaction {
+	p.mutex.Lock()
+	entries := p.sorted(func(a, b *entry) bool { return a.before(b) })
+	p.mutex.Unlock()
+
+	txs := make([]transaction.Transaction, len(entries))
+	for i, e := range entries {
+		txs[i] = e.tx
+	}
+	return txs
+}
+
+// sorted returns the entries in the given order. Caller must hold p.mutex
+func (p *Pool) sorted(less func(a, b *entry) bool) []*entry {
+	entries := make([]*entry, 0, len(p.byID))
+	for _, e := range p.byID {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return less(entries[i], entries[j]) })
+	return entries
+}
+
+// Remove drops the transactions included in a ledger, along with any other
+// pending transaction for the same account and sequence, which can no longer
+// apply
+func (p *Pool) Remove(txs []transaction.Transaction) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for _, tx := range txs {
+		slot := accountSeq{account: tx.GetAccount(), sequence: tx.GetSequence()}
+		if e, ok := p.bySlot[slot]; ok {
+			p.remove(e)
+		}
+	}
+}
+
+// Revalidate checks the pending transactions against the state of a newly
+// closed ledger, closed at closeTime, and drops those that can no longer
+// apply. Transactions are tried in account and sequence order on a scratch
+// copy of the state, so a transaction that depends on an earlier pending one
+// is judged after it. A transaction waiting for a missing earlier sequence,
+// or for the time lock of an escrow to pass, is kept since it may apply in a
+// later ledger. It returns the number of dropped transactions.
+func (p *Pool) Revalidate(st *state.State, closeTime time.Time) int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	scratch := state.New(st.Tree())
+	entries := p.sorted(func(a, b *entry) bool {
+		if a.slot.account != b.slot.account {
+			return a.slot.account < b.slot.account
+		}
+		return a.slot.sequence < b.slot.sequence
+	})
+
+	dropped := 0
+	for _, e := range entries {
+		err := transactor.Apply(scratch, e.tx, closeTime)
+		if err == nil || waitsForSequence(scratch, e.tx, err) || waitsForTime(err) {
+			continue
+		}
+		p.remove(e)
+		dropped++
+	}
+	return dropped
+}
+
+// waitsForSequence reports whether the transaction failed only because its
+// sequence is ahead of the account's
+func waitsForSequence(st *state.State, tx transaction.Transaction, err error) bool {
+	if !errors.Is(err, transactor.ErrBadSequence) {
+		return false
+	}
+	acc, accErr := st.Account(tx.GetAccount())
+	return accErr == nil && tx.GetSequence() > uint64(acc.Sequence)
+}
+
+// waitsForTime reports whether the transaction failed only because the close
+// time has not reached a time lock it depends on
+func waitsForTime(err error) bool {
+	return errors.Is(err, transactor.ErrEscrowNotReady)
+}
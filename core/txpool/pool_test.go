@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package txpool
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ezcon-foundation/go-ezcon/core/amount"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"github.com/ezcon-foundation/go-ezcon/core/transactor"
+	"github.com/ezcon-foundation/go-ezcon/crypto"
+	"github.com/ezcon-foundation/go-ezcon/internal/testkey"
+)
+
+// testKey returns the private key derived from name and the address of the
+// account it controls
+func testKey(t *testing.T, name string) (crypto.PrivateKey, string) {
+	t.Helper()
+	return testkey.Account(t, name)
+}
+
+func testAccount(t *testing.T, name string) string {
+	_, addr := testKey(t, name)
+	return addr
+}
+
+// testTx returns a TrustSet signed by the account derived from name
+func testTx(t *testing.T, name string, seq, fee uint64) transaction.Transaction {
+	t.Helper()
+	priv, account := testKey(t, name)
+	tx := &transaction.TrustSet{
+		BaseTransaction: transaction.BaseTransaction{
+			TxType: "TrustSet", Account: account, Sequence: seq, Fee: fee,
+		},
+		Destination: testAccount(t, "issuer"),
+		Currency:    "USD",
+		Limit:       amount.FromUint64(100),
+	}
+	if err := transaction.Sign(tx, priv); err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestAdd(t *testing.T) {
+	p := New(10)
+	tx := testTx(t, "alice", 1, 10)
+	if _, err := p.Add(tx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Add(tx); !errors.Is(err, ErrDuplicate) {
+		t.Fatalf("duplicate: got %v", err)
+	}
+	if _, err := p.Add(testTx(t, "alice", 1, 5)); !errors.Is(err, ErrSequenceTaken) {
+		t.Fatalf("cheaper replacement: got %v", err)
+	}
+
+	better := testTx(t, "alice", 1, 20)
+	id, err := p.Add(better)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Len() != 1 || !p.Has(id) {
+		t.Fatal("replacement did not take the slot")
+	}
+}
+
+func TestFeeOrderAndEviction(t *testing.T) {
+	p := New(3)
+	for i, fee := range []uint64{20, 50, 30} {
+		if _, err := p.Add(testTx(t, "alice", uint64(i+1), fee)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := p.Add(testTx(t, "bob", 1, 10)); !errors.Is(err, ErrPoolFull) {
+		t.Fatalf("low fee on a full pool: got %v", err)
+	}
+	if _, err := p.Add(testTx(t, "bob", 1, 40)); err != nil {
+		t.Fatal(err)
+	}
+
+	var fees []uint64
+	for _, tx := range p.Transactions() {
+		fees = append(fees, tx.GetFee())
+	}
+	if len(fees) != 3 || fees[0] != 50 || fees[1] != 40 || fees[2] != 30 {
+		t.Fatalf("got fees %v", fees)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	p := New(10)
+	included := testTx(t, "alice", 1, 10)
+	if _, err := p.Add(testTx(t, "alice", 1, 20)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Add(testTx(t, "alice", 2, 10)); err != nil {
+		t.Fatal(err)
+	}
+
+	// a different transaction for the same sequence made it into the ledger
+	p.Remove([]transaction.Transaction{included})
+	txs := p.Transactions()
+	if len(txs) != 1 || txs[0].GetSequence() != 2 {
+		t.Fatalf("got %d transactions", len(txs))
+	}
+}
+
+func TestRevalidate(t *testing.T) {
+	st := state.New(block.NewSHAMap())
+	if err := st.SetParams(&state.Params{BaseFee: 10}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"alice", "issuer"} {
+		if err := transactor.Fund(st, testAccount(t, name), 1000); err != nil {
+			t.Fatal(err)
+		}
+	}
+	alice, err := st.Account(testAccount(t, "alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice.Sequence = 3
+	alice.Balance = 30
+	if err := st.SetAccount(alice); err != nil {
+		t.Fatal(err)
+	}
+
+	p := New(10)
+	for _, tx := range []transaction.Transaction{
+		testTx(t, "alice", 2, 10),  // sequence already used
+		testTx(t, "alice", 3, 20),  // applies
+		testTx(t, "alice", 4, 20),  // balance left after sequence 3 is too low
+		testTx(t, "alice", 6, 10),  // waits for sequence 5
+		testTx(t, "nobody", 1, 10), // account does not exist
+	} {
+		if _, err := p.Add(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	before := st.Tree().RootHash()
+	if dropped := p.Revalidate(st, time.Unix(1_700_000_000, 0)); dropped != 3 {
+		t.Fatalf("dropped %d transactions", dropped)
+	}
+	if !bytes.Equal(before, st.Tree().RootHash()) {
+		t.Fatal("revalidation changed the state")
+	}
+
+	var seqs []uint64
+	for _, tx := range p.Transactions() {
+		seqs = append(seqs, tx.GetSequence())
+	}
+	if len(seqs) != 2 || seqs[0] != 3 || seqs[1] != 6 {
+		t.Fatalf("kept sequences %v", seqs)
+	}
+}
+
+func TestRevalidateKeepsTimeLocked(t *testing.T) {
+	st := state.New(block.NewSHAMap())
+	if err := st.SetParams(&state.Params{BaseFee: 10}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"alice", "bob"} {
+		if err := transactor.Fund(st, testAccount(t, name), 1000); err != nil {
+			t.Fatal(err)
+		}
+	}
+	closeTime := time.Unix(1_700_000_000, 0)
+	priv, alice := testKey(t, "alice")
+	create := &transaction.EscrowCreate{
+		BaseTransaction: transaction.BaseTransaction{
+			TxType: "EscrowCreate", Account: alice, Sequence: 1, Fee: 10,
+		},
+		Destination: testAccount(t, "bob"),
+		Amount:      transaction.Amount{Value: amount.FromUint64(100)},
+		FinishAfter: closeTime.Add(time.Hour),
+	}
+	if err := transaction.Sign(create, priv); err != nil {
+		t.Fatal(err)
+	}
+	if err := transactor.Apply(st, create, closeTime); err != nil {
+		t.Fatal(err)
+	}
+
+	priv, bob := testKey(t, "bob")
+	finish := &transaction.EscrowFinish{
+		BaseTransaction: transaction.BaseTransaction{
+			TxType: "EscrowFinish", Account: bob, Sequence: 1, Fee: 10,
+		},
+		Owner:          alice,
+		EscrowSequence: 1,
+	}
+	if err := transaction.Sign(finish, priv); err != nil {
+		t.Fatal(err)
+	}
+	p := New(10)
+	if _, err := p.Add(finish); err != nil {
+		t.Fatal(err)
+	}
+
+	if dropped := p.Revalidate(st, closeTime); dropped != 0 {
+		t.Fatalf("dropped %d transactions before the finish time", dropped)
+	}
+	if err := transactor.Apply(st, finish, closeTime.Add(2*time.Hour)); err != nil {
+		t.Fatalf("kept finish does not apply later: %v", err)
+	}
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package keys binds the signature schemes of the crypto package to the
+// ledger. Encoded keys start with a scheme byte, so that a public key carried
+// by a transaction or listed in the UNL tells which scheme verifies it.
+package keys
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/ezcon-foundation/go-ezcon/crypto"
+	"github.com/ezcon-foundation/go-ezcon/crypto/ed25519"
+	"github.com/ezcon-foundation/go-ezcon/crypto/eddilithium2"
+)
+
+// SchemeID identifies a signature scheme in encoded keys
+type SchemeID byte
+
+const (
+	SchemeEd25519      SchemeID = 0x01
+	SchemeEdDilithium2 SchemeID = 0x02
+
+	// DefaultScheme is used for keys derived from a bare seed
+	DefaultScheme = SchemeEd25519
+)
+
+var (
+	ErrUnknownScheme = errors.New("keys: unknown signature scheme")
+	ErrKeySize       = errors.New("keys: wrong key size")
+)
+
+var schemes = map[SchemeID]crypto.Scheme{
+	SchemeEd25519:      ed25519.Scheme(),
+	SchemeEdDilithium2: eddilithium2.Scheme(),
+}
+
+// Scheme returns the signature scheme registered under id
+func Scheme(id SchemeID) (crypto.Scheme, error) {
+	s, ok := schemes[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %#x", ErrUnknownScheme, byte(id))
+	}
+	return s, nil
+}
+
+// schemeID returns the id of a registered scheme
+func schemeID(s crypto.Scheme) (SchemeID, error) {
+	for id, registered := range schemes {
+		if registered.Name() == s.Name() {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", ErrUnknownScheme, s.Name())
+}
+
+// GenerateKey creates a random key pair of the given scheme
+func GenerateKey(id SchemeID) (crypto.PublicKey, crypto.PrivateKey, error) {
+	s, err := Scheme(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.GenerateKey()
+}
+
+// FromSeed deterministically derives a key pair of the given scheme from seed
+func FromSeed(id SchemeID, seed []byte) (crypto.PublicKey, crypto.PrivateKey, error) {
+	s, err := Scheme(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(seed) != s.SeedSize() {
+		return nil, nil, crypto.ErrSeedSize
+	}
+	pub, priv := s.DeriveKey(seed)
+	return pub, priv, nil
+}
+
+// MarshalPublicKey encodes a public key as its scheme byte followed by the key
+func MarshalPublicKey(pub crypto.PublicKey) ([]byte, error) {
+	return marshal(pub.Scheme(), pub)
+}
+
+// MarshalPrivateKey encodes a private key as its scheme byte followed by the key
+func MarshalPrivateKey(priv crypto.PrivateKey) ([]byte, error) {
+	return marshal(priv.Scheme(), priv)
+}
+
+func marshal(s crypto.Scheme, key interface{ MarshalBinary() ([]byte, error) }) ([]byte, error) {
+	id, err := schemeID(s)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := key.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{byte(id)}, raw...), nil
+}
+
+// ParsePublicKey decodes a public key encoded by MarshalPublicKey
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	s, raw, err := split(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != s.PublicKeySize() {
+		return nil, ErrKeySize
+	}
+	return s.UnmarshalBinaryPublicKey(raw)
+}
+
+// ParsePrivateKey decodes a private key encoded by MarshalPrivateKey
+func ParsePrivateKey(data []byte) (crypto.PrivateKey, error) {
+	s, raw, err := split(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != s.PrivateKeySize() {
+		return nil, ErrKeySize
+	}
+	return s.UnmarshalBinaryPrivateKey(raw)
+}
+
+func split(data []byte) (crypto.Scheme, []byte, error) {
+	if len(data) == 0 {
+		return nil, nil, ErrKeySize
+	}
+	s, err := Scheme(SchemeID(data[0]))
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, data[1:], nil
+}
+
+// Sign signs message with priv
+func Sign(priv crypto.PrivateKey, message []byte) []byte {
+	return priv.Scheme().Sign(priv, message, nil)
+}
+
+// Verify reports whether sig is a valid signature of message by the public
+// key encoded in pubKey. Malformed keys and signatures are simply invalid.
+func Verify(pubKey, message, sig []byte) bool {
+	pub, err := ParsePublicKey(pubKey)
+	if err != nil {
+		return false
+	}
+	s := pub.Scheme()
+	if len(sig) != s.SignatureSize() {
+		return false
+	}
+	return s.Verify(pub, message, sig, nil)
+}
+
+// AccountHashSize is the size of the hash identifying the account of a key
+const AccountHashSize = 20
+
+// AccountHash returns the hash of an encoded public key that identifies the
+// account it controls
+func AccountHash(pubKey []byte) []byte {
+	sum := sha256.Sum256(pubKey)
+	return sum[:AccountHashSize]
+}
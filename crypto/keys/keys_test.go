@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keys
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	for _, id := range []SchemeID{SchemeEd25519, SchemeEdDilithium2} {
+		pub, priv, err := GenerateKey(id)
+		if err != nil {
+			t.Fatalf("%#x: generate: %v", id, err)
+		}
+		pubKey, err := MarshalPublicKey(pub)
+		if err != nil {
+			t.Fatalf("%#x: marshal: %v", id, err)
+		}
+		if SchemeID(pubKey[0]) != id {
+			t.Fatalf("%#x: encoded key has scheme byte %#x", id, pubKey[0])
+		}
+
+		msg := []byte("ledger")
+		sig := Sign(priv, msg)
+		if !Verify(pubKey, msg, sig) {
+			t.Fatalf("%#x: valid signature rejected", id)
+		}
+		if Verify(pubKey, []byte("other"), sig) {
+			t.Fatalf("%#x: signature accepted for another message", id)
+		}
+		if Verify(pubKey, msg, sig[1:]) {
+			t.Fatalf("%#x: truncated signature accepted", id)
+		}
+	}
+}
+
+func TestFromSeed(t *testing.T) {
+	seed := bytes.Repeat([]byte{7}, 32)
+	pub1, priv1, err := FromSeed(DefaultScheme, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub2, _, err := FromSeed(DefaultScheme, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pub1.Equal(pub2) {
+		t.Fatal("same seed gave different keys")
+	}
+
+	data, err := MarshalPrivateKey(priv1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	priv, err := ParsePrivateKey(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !priv.Equal(priv1) {
+		t.Fatal("private key round trip mismatch")
+	}
+
+	if _, _, err := FromSeed(DefaultScheme, seed[1:]); err == nil {
+		t.Fatal("expected error for short seed")
+	}
+}
+
+func TestParsePublicKeyErrors(t *testing.T) {
+	pub, _, err := GenerateKey(SchemeEd25519)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := MarshalPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ParsePublicKey(data[:len(data)-1]); !errors.Is(err, ErrKeySize) {
+		t.Fatalf("expected ErrKeySize, got %v", err)
+	}
+	bad := append([]byte{0x7f}, data[1:]...)
+	if _, err := ParsePublicKey(bad); !errors.Is(err, ErrUnknownScheme) {
+		t.Fatalf("expected ErrUnknownScheme, got %v", err)
+	}
+}
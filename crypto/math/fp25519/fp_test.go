@@ -7,8 +7,8 @@ import (
 	"math/big"
 	"testing"
 
-	"github.com/cloudflare/circl/internal/conv"
-	"github.com/cloudflare/circl/internal/test"
+	"github.com/ezcon-foundation/go-ezcon/internal/conv"
+	"github.com/ezcon-foundation/go-ezcon/internal/test"
 )
 
 type (
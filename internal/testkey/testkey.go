@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package testkey derives deterministic keys for tests
+package testkey
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/crypto"
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+)
+
+// New derives a key pair from name and returns the private key and the
+// encoded public key
+func New(t testing.TB, name string) (crypto.PrivateKey, []byte) {
+	t.Helper()
+	seed := sha256.Sum256([]byte(name))
+	pub, priv, err := keys.FromSeed(keys.DefaultScheme, seed[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey, err := keys.MarshalPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return priv, pubKey
+}
+
+// Account returns the private key derived from name and the address of the
+// account it controls
+func Account(t testing.TB, name string) (crypto.PrivateKey, string) {
+	t.Helper()
+	priv, pubKey := New(t, name)
+	return priv, address.FromPublicKey(pubKey)
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/ezcon-foundation/go-ezcon/consensus"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/storage"
+	"log"
+)
+
+// maxAcquireDepth là số ledger tối đa node tải lại từ mạng trong một lần
+const maxAcquireDepth = 256
+
+// serveLedgerData đọc header hoặc node cây trong kho để trả cho node khác
+func (n *Node) serveLedgerData(kind consensus.LedgerData, hash []byte) ([]byte, error) {
+	switch kind {
+	case consensus.LedgerHeader:
+		header, err := n.Store.ReadHeader(hash)
+		if err != nil {
+			return nil, err
+		}
+		return header.MarshalBinary()
+	case consensus.LedgerNode:
+		return n.Store.ReadNode(hash)
+	}
+	return nil, fmt.Errorf("unknown ledger data %v", kind)
+}
+
+// wrongLedger bắt đầu tải ledger seq có hash mà mạng đã xác nhận. Mỗi lúc chỉ
+// có một lần tải, lần tải thất bại được thử lại ở lần xác nhận tiếp theo
+func (n *Node) wrongLedger(seq uint64, hash []byte) {
+	if !n.acquiring.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer n.acquiring.Store(false)
+
+		if err := n.acquireLedger(seq, hash); err != nil {
+			log.Printf("Acquire ledger %d hash %x failed: %v", seq, hash, err)
+		}
+	}()
+}
+
+// acquireLedger tải từ node khác các ledger từ ledger đầu tiên node đã có
+// tới ledger seq có hash, kiểm tra từng header và từng node cây theo hash, lưu
+// lại rồi chuyển node sang ledger đó
+func (n *Node) acquireLedger(seq uint64, hash []byte) error {
+	log.Printf("Acquiring ledger %d hash %x", seq, hash)
+
+	// tải header ngược theo ParentHash tới ledger đã có trong kho
+	var headers []*block.BlockHeader
+	next, index := hash, seq
+	for {
+		_, err := n.Store.ReadHeader(next)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		if len(headers) == maxAcquireDepth {
+			return fmt.Errorf("no common ledger within %d ledgers", maxAcquireDepth)
+		}
+
+		header, err := n.fetchHeader(next)
+		if err != nil {
+			return err
+		}
+		if header.Index != index || index == 0 {
+			return fmt.Errorf("ledger %x has index %d, want %d", next, header.Index, index)
+		}
+		headers = append(headers, header)
+		next, index = header.ParentHash, index-1
+	}
+
+	// tải cây trạng thái và cây giao dịch từ ledger cũ nhất, node cây đã có
+	// trong kho không cần tải lại
+	var acquired *block.Block
+	for i := len(headers) - 1; i >= 0; i-- {
+		header := headers[i]
+		accounts, err := n.loadTree(header.StateHash)
+		if err != nil {
+			return fmt.Errorf("state tree of ledger %d: %w", header.Index, err)
+		}
+		txs, err := n.loadTree(header.TxHash)
+		if err != nil {
+			return fmt.Errorf("transaction tree of ledger %d: %w", header.Index, err)
+		}
+
+		acquired = &block.Block{Header: *header, Accounts: *accounts, Transactions: *txs}
+		if i > 0 {
+			if err := n.Store.WriteBlock(acquired); err != nil {
+				return err
+			}
+		}
+	}
+
+	// ledger đích đã có sẵn trong kho, ví dụ khi node đã đóng nó trước đó
+	if acquired == nil {
+		b, err := n.Store.ReadBlockByHash(hash)
+		if err != nil {
+			return err
+		}
+		acquired = b
+	}
+
+	err := n.Consensus.Resync(acquired, func() error {
+		return n.Ledger.Switch(acquired)
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := n.Ledger.SetValidated(hash); err != nil {
+		return err
+	}
+
+	// giao dịch trong pool được kiểm tra lại theo trạng thái của ledger mới
+	if dropped := n.Pool.Revalidate(state.New(&acquired.Accounts), acquired.Header.CloseTime); dropped > 0 {
+		log.Printf("Dropped %d pending transactions after acquiring ledger %d", dropped, seq)
+	}
+
+	log.Printf("Acquired ledger %d hash %x, %d ledgers downloaded", seq, hash, len(headers))
+	return nil
+}
+
+// fetchHeader tải header của ledger có hash từ node khác
+func (n *Node) fetchHeader(hash []byte) (*block.BlockHeader, error) {
+	data, err := n.Consensus.FetchLedgerData(consensus.LedgerHeader, [][]byte{hash})
+	if err != nil {
+		return nil, err
+	}
+	var header block.BlockHeader
+	if err := header.UnmarshalBinary(data[0]); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+// loadTree dựng lại cây có root, tải từ node khác những node cây chưa có
+// trong kho. Cây được tải từng tầng một: mọi node còn thiếu của một tầng được
+// yêu cầu cùng lúc. Node đã có trong kho thì cả cây con của nó cũng có, nên
+// không cần đi xuống nữa. Hash của từng node được LoadSHAMap kiểm tra lại
+func (n *Node) loadTree(root []byte) (*block.SHAMap, error) {
+	fetched := make(map[string][]byte)
+	level := [][]byte{root}
+	if len(root) == 0 || bytes.Equal(root, make([]byte, len(root))) {
+		level = nil
+	}
+
+	for len(level) > 0 {
+		var missing [][]byte
+		for _, hash := range level {
+			if _, ok := fetched[string(hash)]; ok {
+				continue
+			}
+			_, err := n.Store.ReadNode(hash)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, storage.ErrNotFound) {
+				return nil, err
+			}
+			fetched[string(hash)] = nil
+			missing = append(missing, hash)
+		}
+
+		blobs, err := n.Consensus.FetchLedgerData(consensus.LedgerNode, missing)
+		if err != nil {
+			return nil, err
+		}
+		level = nil
+		for i, blob := range blobs {
+			fetched[string(missing[i])] = blob
+			children, err := block.ChildHashes(blob)
+			if err != nil {
+				return nil, err
+			}
+			level = append(level, children...)
+		}
+	}
+
+	return block.LoadSHAMap(root, func(hash []byte) ([]byte, error) {
+		if blob := fetched[string(hash)]; blob != nil {
+			return blob, nil
+		}
+		return n.Store.ReadNode(hash)
+	})
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"log"
+	"net/http"
+)
+
+type EscrowCreateRequest struct {
+	RawTx map[string]interface{} `json:"tx"`
+}
+
+type EscrowCreateResponse struct {
+	Status      string `json:"status"`
+	TxID        string `json:"tx_id"`
+	LedgerIndex uint64 `json:"ledger_index"`
+}
+
+func (n *Node) EscrowCreate(r *http.Request, args *EscrowCreateRequest, reply *EscrowCreateResponse) error {
+
+	log.Println("EscrowCreate called with args:", args)
+
+	txID, err := n.submit(args.RawTx, transaction.TxTypeEscrowCreate)
+	if err != nil {
+		return err
+	}
+
+	reply.Status = "queued"
+	reply.TxID = txID.String()
+	return nil
+}
+
+type EscrowFinishRequest struct {
+	RawTx map[string]interface{} `json:"tx"`
+}
+
+type EscrowFinishResponse struct {
+	Status      string `json:"status"`
+	TxID        string `json:"tx_id"`
+	LedgerIndex uint64 `json:"ledger_index"`
+}
+
+func (n *Node) EscrowFinish(r *http.Request, args *EscrowFinishRequest, reply *EscrowFinishResponse) error {
+
+	log.Println("EscrowFinish called with args:", args)
+
+	txID, err := n.submit(args.RawTx, transaction.TxTypeEscrowFinish)
+	if err != nil {
+		return err
+	}
+
+	reply.Status = "queued"
+	reply.TxID = txID.String()
+	return nil
+}
+
+type EscrowCancelRequest struct {
+	RawTx map[string]interface{} `json:"tx"`
+}
+
+type EscrowCancelResponse struct {
+	Status      string `json:"status"`
+	TxID        string `json:"tx_id"`
+	LedgerIndex uint64 `json:"ledger_index"`
+}
+
+func (n *Node) EscrowCancel(r *http.Request, args *EscrowCancelRequest, reply *EscrowCancelResponse) error {
+
+	log.Println("EscrowCancel called with args:", args)
+
+	txID, err := n.submit(args.RawTx, transaction.TxTypeEscrowCancel)
+	if err != nil {
+		return err
+	}
+
+	reply.Status = "queued"
+	reply.TxID = txID.String()
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node
+
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/ledger"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/storage"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"log"
+	"time"
+)
+
+// initLedger tạo ledger 0 từ genesis file khi kho lưu trữ còn trống
+func initLedger(store *storage.LedgerStore, genesisFile string) (*ledger.Ledger, error) {
+	if genesisFile == "" {
+		log.Println("Ledger store is empty, waiting for a genesis ledger")
+		return ledger.New(store, nil), nil
+	}
+
+	spec, err := ledger.LoadGenesisSpec(genesisFile)
+	if err != nil {
+		return nil, err
+	}
+	genesis, err := ledger.InitGenesis(store, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Created genesis ledger hash %x", genesis.Header.Hash)
+	return ledger.New(store, genesis), nil
+}
+
+// closeLedger đóng ledger tiếp theo từ tập giao dịch mà consensus đã thống nhất
+func (n *Node) closeLedger(txs []transaction.Transaction, closeTime time.Time) (*block.Block, error) {
+	closed, results, err := n.Ledger.Close(txs, closeTime)
+	if err != nil {
+		return nil, err
+	}
+
+	included := make([]transaction.Transaction, 0, len(results))
+	for _, r := range results {
+		if r.Err != nil {
+			log.Printf("Transaction %v not applied: %v", r.Key, r.Err)
+			continue
+		}
+		included = append(included, r.Tx)
+	}
+
+	// bỏ các giao dịch đã vào ledger khỏi pool, rồi kiểm tra lại phần còn lại theo trạng thái mới
+	n.Pool.Remove(included)
+	if dropped := n.Pool.Revalidate(state.New(&closed.Accounts), closed.Header.CloseTime); dropped > 0 {
+		log.Printf("Dropped %d pending transactions after ledger %d", dropped, closed.Header.Index)
+	}
+
+	log.Printf("Closed ledger %d hash %x with %d transactions",
+		closed.Header.Index, closed.Header.Hash, closed.Transactions.Len())
+	return closed, nil
+}
+
+// validatedLedger đánh dấu ledger đã được đủ quorum validator xác nhận. Node
+// chỉ đánh dấu được ledger mà nó đã đóng với cùng hash
+func (n *Node) validatedLedger(seq uint64, hash []byte) {
+	validated, err := n.Ledger.SetValidated(hash)
+	if err != nil {
+		log.Printf("Validated ledger %d hash %x is not in the local ledger: %v", seq, hash, err)
+		return
+	}
+	log.Printf("Validated ledger %d hash %x", validated.Header.Index, validated.Header.Hash)
+}
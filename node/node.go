@@ -18,16 +18,27 @@
 package node
 
 import (
+	"errors"
 	"github.com/ezcon-foundation/go-ezcon/config"
 	"github.com/ezcon-foundation/go-ezcon/consensus"
+	"github.com/ezcon-foundation/go-ezcon/core/ledger"
+	"github.com/ezcon-foundation/go-ezcon/core/storage"
+	"github.com/ezcon-foundation/go-ezcon/core/txpool"
 	"github.com/ezcon-foundation/go-ezcon/node/tcp"
 	"github.com/gorilla/rpc/v2"
 	"github.com/gorilla/rpc/v2/json2"
+	"sync/atomic"
 )
 
 type Node struct {
 	Consensus *consensus.Consensus
 	RPCServer *rpc.Server
+	Store     *storage.LedgerStore
+	Ledger    *ledger.Ledger
+	Pool      *txpool.Pool
+
+	// acquiring cho biết node đang tải ledger mạng đã xác nhận từ node khác
+	acquiring atomic.Bool
 
 	proposalChan <-chan tcp.Message // Kênh nhận các message dạng đề xuất
 	voteChan     <-chan tcp.Message // Kênh nhận các message dạn
@@ -35,28 +46,68 @@ type Node struct {
 
 func NewNode(cfg *config.Config) (*Node, error) {
 
+	// mở kho lưu trữ ledger tại LedgerPath, để trống thì chỉ lưu trong bộ nhớ
+	store, err := storage.Open(cfg.LedgerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// khôi phục ledger đã đóng gần nhất từ kho lưu trữ
+	lg, err := ledger.Load(store)
+	if errors.Is(err, storage.ErrNotFound) {
+		lg, err = initLedger(store, cfg.GenesisFile)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	// create rpc server
 	s := rpc.NewServer()
 
 	// regis codec for rpc server
 	s.RegisterCodec(json2.NewCodec(), "application/json")
 
-	c := consensus.NewConsensus(
+	// pool giao dịch chờ, dùng chung giữa RPC và consensus
+	pool := txpool.New(cfg.TxPoolSize)
+
+	c, err := consensus.NewConsensus(
 		cfg.UNL,
 		cfg.UNLPublicKey,
 		cfg.NodeID,
 		cfg.PrivKey,
 		cfg.ConsensusPort,
+		pool,
+		consensus.Timing{
+			Heartbeat:      cfg.Heartbeat,
+			LedgerInterval: cfg.LedgerInterval,
+			AvalancheStep:  cfg.AvalancheStep,
+			MaxEstablish:   cfg.MaxEstablish,
+		},
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	// init node parameter
 	node := &Node{
 		RPCServer: s,
 		Consensus: c,
+		Store:     store,
+		Ledger:    lg,
+		Pool:      pool,
 	}
 
+	// khi consensus thống nhất tập giao dịch thì node đóng ledger tiếp theo
+	c.SetAcceptHandler(node.closeLedger)
+	c.SetValidatedHandler(node.validatedLedger)
+
+	// node trả dữ liệu ledger cho node khác và tự tải ledger khi lệch khỏi mạng
+	c.SetLedgerDataHandler(node.serveLedgerData)
+	c.SetWrongLedgerHandler(node.wrongLedger)
+	c.SetLedger(lg.Closed())
+
 	// regis server under name 'ezcon'
-	err := s.RegisterService(node, "ezcon")
+	err = s.RegisterService(node, "ezcon")
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/state"
+	"github.com/ezcon-foundation/go-ezcon/core/transaction"
+	"github.com/ezcon-foundation/go-ezcon/core/transactor"
+	"log"
+)
+
+// submit kiểm tra giao dịch loại txType gửi qua RPC và đưa vào pool, trả về tx ID
+func (n *Node) submit(rawTx map[string]interface{}, txType transaction.TxType) (block.Key, error) {
+	tx, err := transaction.ParseTransaction(rawTx)
+	if err != nil {
+		return block.Key{}, err
+	}
+
+	// mỗi RPC chỉ nhận đúng loại giao dịch của nó
+	if tx.GetTxType() != txType {
+		return block.Key{}, fmt.Errorf("transaction type %v, want %v", tx.GetTxType(), txType)
+	}
+
+	// kiểm tra chữ ký và các điều kiện không phụ thuộc trạng thái trước khi nhận giao dịch
+	networkID, err := n.networkID()
+	if err != nil {
+		return block.Key{}, err
+	}
+	if err := transactor.Preflight(tx, networkID); err != nil {
+		return block.Key{}, err
+	}
+
+	// đưa vào pool, pool từ chối giao dịch trùng lặp
+	id, err := n.Pool.Add(tx)
+	if err != nil {
+		return id, err
+	}
+
+	// chuyển tiếp cho các validator khác, lỗi mạng không làm hỏng giao dịch đã vào pool
+	if n.Consensus != nil {
+		if err := n.Consensus.Relay(tx); err != nil {
+			log.Printf("Relay transaction %x failed: %v", id, err)
+		}
+	}
+	return id, nil
+}
+
+// networkID trả về mạng của ledger đã đóng gần nhất, 0 khi ledger chưa có tham số mạng
+func (n *Node) networkID() (uint32, error) {
+	closed := n.Ledger.Closed()
+	if closed == nil {
+		return 0, nil
+	}
+	params, err := state.New(&closed.Accounts).Params()
+	if errors.Is(err, state.ErrParamsNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return params.NetworkID, nil
+}
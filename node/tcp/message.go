@@ -17,12 +17,110 @@
 
 package tcp
 
+import (
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/core/codec"
+	"github.com/ezcon-foundation/go-ezcon/crypto"
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+)
+
+// ProtocolVersion là phiên bản giao thức của message, message khác phiên bản bị bỏ qua
+const ProtocolVersion uint32 = 1
+
+// MessageType cho biết nội dung của message, server chuyển message tới nơi xử lý theo loại
+type MessageType uint32
+
+const (
+	MessageProposal       MessageType = iota + 1 // vị trí đầu tiên của validator trong vòng đồng thuận
+	MessagePositionUpdate                        // vị trí mới của validator trong lúc establish
+	MessageValidation                            // xác nhận của validator cho ledger đã đóng
+	MessageTxRelay                               // giao dịch được chuyển tiếp giữa các node
+	MessageLedgerRequest                         // yêu cầu dữ liệu ledger từ node khác
+	MessageLedgerData                            // dữ liệu ledger trả lời một yêu cầu
+)
+
+func (t MessageType) String() string {
+	switch t {
+	case MessageProposal:
+		return "proposal"
+	case MessagePositionUpdate:
+		return "position update"
+	case MessageValidation:
+		return "validation"
+	case MessageTxRelay:
+		return "tx relay"
+	case MessageLedgerRequest:
+		return "ledger request"
+	case MessageLedgerData:
+		return "ledger data"
+	}
+	return "unknown"
+}
+
+// hashPrefixMessage tách chữ ký message khỏi chữ ký giao dịch và claim
+var hashPrefixMessage = []byte("MSG\x00")
+
 // Message định nghĩa dữ liệu gửi/nhận qua TCP
 type Message struct {
+	Type      MessageType `json:"type"`
+	Version   uint32      `json:"version"`
+	NetworkID uint32      `json:"network_id"`
+
+	// Ledger mà message nói tới và lần thử đồng thuận của ledger đó
+	LedgerSeq uint64 `json:"ledger_seq"`
+	Round     uint32 `json:"round"`
 
-	// Danh sách các giao dịch
-	Txs []byte `json:"txs"`
+	// Public key của node gửi, như trong unl_public_key
+	Sender string `json:"sender"`
 
-	// Chữ ký của node
+	// Nội dung của message, mã hoá tuỳ theo Type
+	Payload []byte `json:"payload"`
+
+	// Chữ ký của node gửi trên toàn bộ các trường còn lại
 	Sig []byte `json:"sig"`
+
+	// From là địa chỉ của kết nối mà server nhận message, không được gửi đi
+	// và không nằm trong chữ ký
+	From string `json:"-"`
+}
+
+// SigningData trả về dữ liệu được ký: mã hoá chuẩn của mọi trường trừ Sig
+// và From, sau một tiền tố cố định
+func (m *Message) SigningData() ([]byte, error) {
+	e := codec.NewEncoder()
+	e.Uint32(codec.FieldMessageType, uint32(m.Type))
+	e.Uint32(codec.FieldVersion, m.Version)
+	e.Uint32(codec.FieldNetworkID, m.NetworkID)
+	e.Uint32(codec.FieldRound, m.Round)
+	e.Uint64(codec.FieldIndex, m.LedgerSeq)
+	e.Blob(codec.FieldPayload, m.Payload)
+	e.String(codec.FieldPublicKey, m.Sender)
+	data, err := e.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte(nil), hashPrefixMessage...), data...), nil
+}
+
+// Sign ký message bằng khoá của node gửi
+func (m *Message) Sign(priv crypto.PrivateKey) error {
+	data, err := m.SigningData()
+	if err != nil {
+		return err
+	}
+	m.Sig = keys.Sign(priv, data)
+	return nil
+}
+
+// Verify kiểm tra chữ ký của message theo public key Sender
+func (m *Message) Verify() bool {
+	pubKey, err := address.ParseNodePublicKey(m.Sender)
+	if err != nil {
+		return false
+	}
+	data, err := m.SigningData()
+	if err != nil {
+		return false
+	}
+	return keys.Verify(pubKey, data, m.Sig)
 }
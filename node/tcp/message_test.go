@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tcp
+
+import (
+	"bytes"
+	"github.com/ezcon-foundation/go-ezcon/core/address"
+	"github.com/ezcon-foundation/go-ezcon/crypto/keys"
+	"testing"
+)
+
+func TestMessageSignature(t *testing.T) {
+	pub, priv, err := keys.FromSeed(keys.DefaultScheme, bytes.Repeat([]byte{7}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey, err := keys.MarshalPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := Message{
+		Type:      MessageProposal,
+		Version:   ProtocolVersion,
+		NetworkID: 1,
+		LedgerSeq: 5,
+		Sender:    address.EncodeNodePublicKey(pubKey),
+		Payload:   []byte("position"),
+	}
+	if err := msg.Sign(priv); err != nil {
+		t.Fatal(err)
+	}
+	if !msg.Verify() {
+		t.Fatal("signed message does not verify")
+	}
+
+	// mọi trường của phong bì đều được ký
+	for name, edit := range map[string]func(m *Message){
+		"type":    func(m *Message) { m.Type = MessagePositionUpdate },
+		"network": func(m *Message) { m.NetworkID = 2 },
+		"ledger":  func(m *Message) { m.LedgerSeq = 6 },
+		"round":   func(m *Message) { m.Round = 1 },
+		"payload": func(m *Message) { m.Payload = []byte("other") },
+	} {
+		tampered := msg
+		edit(&tampered)
+		if tampered.Verify() {
+			t.Errorf("message with changed %s verifies", name)
+		}
+	}
+}
@@ -25,12 +25,12 @@ import (
 	"net"
 )
 
-// TCPServer quản lý server nhận candidate set
+// TCPServer quản lý server nhận message từ các node khác
 type TCPServer struct {
-	listener     net.Listener
-	proposalChan chan<- Message
-	voteChan     chan<- Message
-	isConsensing func() bool
+	listener net.Listener
+
+	// routes là kênh nhận message của từng loại
+	routes map[MessageType]chan<- Message
 }
 
 // NewTCPServer khởi tạo server
@@ -41,18 +41,20 @@ func NewTCPServer(port string) (*TCPServer, error) {
 	}
 	return &TCPServer{
 		listener: listener,
+		routes:   make(map[MessageType]chan<- Message),
 	}, nil
 }
 
+// Route chuyển các message loại t tới ch. Chỉ gọi trước Start
+func (s *TCPServer) Route(t MessageType, ch chan<- Message) {
+	s.routes[t] = ch
+}
+
 // Start chạy server TCP
-func (s *TCPServer) Start(isConsensing func() bool, proposalChan, voteChan chan<- Message) {
+func (s *TCPServer) Start() {
 
 	log.Printf("Start Consensus TCP")
 
-	s.isConsensing = isConsensing
-	s.proposalChan = proposalChan
-	s.voteChan = voteChan
-
 	defer s.listener.Close()
 
 	for {
@@ -85,20 +87,23 @@ func (s *TCPServer) handleConnection(conn net.Conn) {
 		return
 	}
 
-	log.Printf("Receive msg: %+v", msg)
+	msg.From = conn.RemoteAddr().String()
+	log.Printf("Receive %v message from %v", msg.Type, msg.Sender)
 
-	// Phân loại message dựa trên trạng thái đồng thuận
-	if s.isConsensing != nil && s.isConsensing() {
-		select {
-		case s.voteChan <- msg:
-		default:
-			log.Printf("Vote channel full, dropping message")
-		}
-	} else {
-		select {
-		case s.proposalChan <- msg:
-		default:
-			log.Printf("Proposal channel full, dropping message")
-		}
+	if msg.Version != ProtocolVersion {
+		log.Printf("Unsupported protocol version %d, dropping message", msg.Version)
+		return
+	}
+
+	// Phân loại message dựa trên loại message
+	ch, ok := s.routes[msg.Type]
+	if !ok {
+		log.Printf("No handler for %v message, dropping message", msg.Type)
+		return
+	}
+	select {
+	case ch <- msg:
+	default:
+		log.Printf("%v channel full, dropping message", msg.Type)
 	}
 }
@@ -37,11 +37,12 @@ func (n *Node) TrustSet(r *http.Request, args *TrustSetRequest, reply *TrustSetR
 
 	log.Println("TrustSet called with args:", args)
 
-	trustSetTx, err := transaction.ParseTransaction(args.RawTx)
+	txID, err := n.submit(args.RawTx, transaction.TxTypeTrustSet)
 	if err != nil {
 		return err
 	}
 
-	n.Consensus.Transactions = append(n.Consensus.Transactions, &trustSetTx)
+	reply.Status = "queued"
+	reply.TxID = txID.String()
 	return nil
 }
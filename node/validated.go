@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2025 EZCON Foundation.
+ *
+ * The go-ezcon library is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The go-ezcon library is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with the go-ezcon library. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"github.com/ezcon-foundation/go-ezcon/core/block"
+	"github.com/ezcon-foundation/go-ezcon/core/storage"
+	"log"
+	"net/http"
+	"time"
+)
+
+type ValidatedLedgerRequest struct{}
+
+type ValidatedLedgerResponse struct {
+	LedgerIndex uint64    `json:"ledger_index"`
+	LedgerHash  string    `json:"ledger_hash"`
+	CloseTime   time.Time `json:"close_time"`
+}
+
+// ValidatedLedger trả về ledger được xác nhận đầy đủ gần nhất
+func (n *Node) ValidatedLedger(r *http.Request, args *ValidatedLedgerRequest, reply *ValidatedLedgerResponse) error {
+
+	log.Println("ValidatedLedger called")
+
+	validated := n.Ledger.Validated()
+	if validated == nil {
+		return errors.New("no validated ledger yet")
+	}
+
+	reply.LedgerIndex = validated.Header.Index
+	reply.LedgerHash = hex.EncodeToString(validated.Header.Hash)
+	reply.CloseTime = validated.Header.CloseTime
+	return nil
+}
+
+type TransactionStatusRequest struct {
+	TxID string `json:"tx_id"`
+}
+
+type TransactionStatusResponse struct {
+	TxID        string `json:"tx_id"`
+	LedgerIndex uint64 `json:"ledger_index"`
+	Validated   bool   `json:"validated"`
+}
+
+// TransactionStatus trả về ledger chứa giao dịch. Giao dịch chỉ là cuối cùng
+// khi ledger đó đã được xác nhận đầy đủ
+func (n *Node) TransactionStatus(r *http.Request, args *TransactionStatusRequest, reply *TransactionStatusResponse) error {
+
+	log.Println("TransactionStatus called with args:", args)
+
+	id, err := block.ParseKey(args.TxID)
+	if err != nil {
+		return err
+	}
+
+	_, index, err := n.Store.ReadTransaction(id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return errors.New("transaction not found in a closed ledger")
+	}
+	if err != nil {
+		return err
+	}
+
+	reply.TxID = id.String()
+	reply.LedgerIndex = index
+
+	// ledger đã đóng có thể nằm trên nhánh bị bỏ, chỉ ledger cùng index trên
+	// chuỗi đã xác nhận mới tính
+	header, err := n.Ledger.ValidatedHeader(index)
+	if err != nil || header == nil {
+		return err
+	}
+	txs, err := block.LoadSHAMap(header.TxHash, n.Store.ReadNode)
+	if err != nil {
+		return err
+	}
+	reply.Validated = txs.Has(id)
+	return nil
+}